@@ -39,6 +39,24 @@ func Execute(fs afero.Fs, args []string) {
 	lockName := flags.String("lock", "", "lock name")
 	receiver := flags.String("receiver", "", "receiver name; default first letter of type name")
 	output := flags.String("output", "", "output file name; default <type_name>_accessor.go")
+	builder := flags.Bool("builder", false, "generate builder type")
+	funcOptions := flags.Bool("options", false, "generate functional options and constructor")
+	constructor := flags.Bool("constructor", false, "generate constructor taking required fields")
+	constructorName := flags.String("constructor-name", "", "constructor name; default New<type_name>")
+	registry := flags.Bool("registry", false, "generate methods getting and setting fields by name")
+	jsonMethods := flags.Bool("json", false, "generate MarshalJSON and UnmarshalJSON through accessors")
+	logValuer := flags.Bool("log", false, "generate LogValue, String and GoString with secret fields redacted")
+	iface := flags.Bool("interface", false, "generate interfaces of accessor methods reading and writing fields")
+	readerName := flags.String("reader-name", "", "name of the interface of methods reading fields; default <type_name>Reader")
+	writerName := flags.String("writer-name", "", "name of the interface of methods writing fields; default <type_name>Writer")
+	fake := flags.Bool("fake", false, "generate fakes of the interfaces into <output>_fake_test.go; implies -interface")
+	view := flags.Bool("view", false, "generate read-only view named <type_name>View exposing getters")
+	clone := flags.Bool("clone", false, "generate Clone method returning a deep copy")
+	equal := flags.Bool("equal", false, "generate Equal method comparing tagged fields")
+	patch := flags.Bool("patch", false, "generate patch type named <type_name>Patch and ApplyPatch method")
+	skipExisting := flags.Bool("skip-existing", false, "skip accessor methods already declared by hand")
+	configPath := flags.String("config", "", "path of JSON config file, e.g. to add initialisms")
+	dirty := flags.String("dirty", "", "name of map[string]struct{} field recording fields set by setters")
 
 	if err := flags.Parse(args[1:]); err != nil {
 		flags.Usage()
@@ -83,6 +101,31 @@ func Execute(fs afero.Fs, args []string) {
 		accessor.Output(*output),
 		accessor.Receiver(*receiver),
 		accessor.Lock(*lockName),
+		accessor.Builder(*builder),
+		accessor.Options(*funcOptions),
+		accessor.Constructor(*constructor),
+		accessor.ConstructorName(*constructorName),
+		accessor.Dirty(*dirty),
+		accessor.Registry(*registry),
+		accessor.JSON(*jsonMethods),
+		accessor.LogValuer(*logValuer),
+		accessor.Interface(*iface),
+		accessor.ReaderName(*readerName),
+		accessor.WriterName(*writerName),
+		accessor.Fake(*fake),
+		accessor.View(*view),
+		accessor.Clone(*clone),
+		accessor.Equal(*equal),
+		accessor.Patch(*patch),
+		accessor.SkipExisting(*skipExisting),
+	}
+
+	if *configPath != "" {
+		config, err := accessor.LoadConfig(*configPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		options = append(options, accessor.Initialisms(config.Initialisms...))
 	}
 
 	if err = accessor.Generate(fs, src, options...); err != nil {
@@ -62,6 +62,114 @@ func TestExecute(t *testing.T) {
 			cmd:    "accessory -type Tester -lock lock testdata/with_rwmutex",
 			output: "testdata/with_rwmutex/tester_accessor.go",
 		},
+		"With": {
+			cmd:    "accessory -type Tester testdata/with",
+			output: "testdata/with/tester_accessor.go",
+		},
+		"Builder": {
+			cmd:    "accessory -type Tester -builder testdata/builder",
+			output: "testdata/builder/tester_accessor.go",
+		},
+		"Options": {
+			cmd:    "accessory -type Tester -options testdata/options",
+			output: "testdata/options/tester_accessor.go",
+		},
+		"Constructor": {
+			cmd:    "accessory -type Tester -constructor -constructor-name Create testdata/constructor",
+			output: "testdata/constructor/tester_accessor.go",
+		},
+		"DefaultValue": {
+			cmd:    "accessory -type Tester testdata/default_value",
+			output: "testdata/default_value/tester_accessor.go",
+		},
+		"Lazy": {
+			cmd:    "accessory -type Tester -lock lock testdata/lazy",
+			output: "testdata/lazy/tester_accessor.go",
+		},
+		"Optional": {
+			cmd:    "accessory -type Tester -lock lock testdata/optional",
+			output: "testdata/optional/tester_accessor.go",
+		},
+		"Unwrap": {
+			cmd:    "accessory -type Tester -lock lock testdata/unwrap",
+			output: "testdata/unwrap/tester_accessor.go",
+		},
+		"Swap": {
+			cmd:    "accessory -type Tester -lock lock testdata/swap",
+			output: "testdata/swap/tester_accessor.go",
+		},
+		"OnChange": {
+			cmd:    "accessory -type Tester -lock lock testdata/on_change",
+			output: "testdata/on_change/tester_accessor.go",
+		},
+		"Dirty": {
+			cmd:    "accessory -type Tester -lock lock -dirty dirty testdata/dirty",
+			output: "testdata/dirty/tester_accessor.go",
+		},
+		"Registry": {
+			cmd:    "accessory -type Tester -registry testdata/registry",
+			output: "testdata/registry/tester_accessor.go",
+		},
+		"RegistryReceiver": {
+			cmd:    "accessory -type Vehicle -registry testdata/registry_receiver",
+			output: "testdata/registry_receiver/vehicle_accessor.go",
+		},
+		"JSON": {
+			cmd:    "accessory -type Tester -lock lock -json testdata/json",
+			output: "testdata/json/tester_accessor.go",
+		},
+		"JSONReceiver": {
+			cmd:    "accessory -type Vehicle -json testdata/json_receiver",
+			output: "testdata/json_receiver/vehicle_accessor.go",
+		},
+		"LogValue": {
+			cmd:    "accessory -type Tester -lock lock -log testdata/log_value",
+			output: "testdata/log_value/tester_accessor.go",
+		},
+		"Interface": {
+			cmd:    "accessory -type Tester -interface -writer-name TesterMutator testdata/interface",
+			output: "testdata/interface/tester_accessor.go",
+		},
+		"Fake": {
+			cmd:    "accessory -type Tester -fake testdata/fake",
+			output: "testdata/fake/tester_accessor_fake_test.go",
+		},
+		"View": {
+			cmd:    "accessory -type Tester -lock lock -view testdata/view",
+			output: "testdata/view/tester_accessor.go",
+		},
+		"Clone": {
+			cmd:    "accessory -type Tester -lock lock -clone testdata/clone",
+			output: "testdata/clone/tester_accessor.go",
+		},
+		"Equal": {
+			cmd:    "accessory -type Tester -lock lock -equal testdata/equal",
+			output: "testdata/equal/tester_accessor.go",
+		},
+		"Patch": {
+			cmd:    "accessory -type Tester -lock lock -dirty dirty -patch testdata/patch",
+			output: "testdata/patch/tester_accessor.go",
+		},
+		"PatchReceiver": {
+			cmd:    "accessory -type Person -lock mu -patch testdata/patch_receiver",
+			output: "testdata/patch_receiver/person_accessor.go",
+		},
+		"Directive": {
+			cmd:    "accessory -type Tester -lock lock -dirty dirty -json testdata/directive",
+			output: "testdata/directive/tester_accessor.go",
+		},
+		"FieldComment": {
+			cmd:    "accessory -type Tester testdata/field_comment",
+			output: "testdata/field_comment/tester_accessor.go",
+		},
+		"SkipExisting": {
+			cmd:    "accessory -type Tester -output previous_accessor.go -skip-existing testdata/skip_existing",
+			output: "testdata/skip_existing/previous_accessor.go",
+		},
+		"Initialisms": {
+			cmd:    "accessory -type HTTPServer -config testdata/initialisms/accessory.json testdata/initialisms",
+			output: "testdata/initialisms/http_server_accessor.go",
+		},
 	}
 
 	fs := afero.NewMemMapFs()
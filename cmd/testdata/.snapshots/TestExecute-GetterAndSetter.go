@@ -30,7 +30,7 @@ func (t *Tester) SetSecondField(val int32) {
 	t.field2 = val
 }
 
-func (t *Tester) Field4() string {
+func (t *Tester) GetField4() string {
 	if t == nil {
 		return ""
 	}
@@ -0,0 +1,21 @@
+package test
+
+import (
+	"errors"
+	"time"
+)
+
+type Tester struct {
+	field1 string    `accessor:"getter,required"`
+	field2 int32     `accessor:"getter,setter"`
+	field3 time.Time `accessor:"getter,required"`
+	field4 *bool
+	set    bool `accessor:"getter"`
+}
+
+func (t *Tester) Validate() error {
+	if t.field2 < 0 {
+		return errors.New("field2 must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package test
+
+import "sync"
+
+type Node struct {
+	children []*Node
+}
+
+func (n *Node) Clone() *Node {
+	if n == nil {
+		return nil
+	}
+	return &Node{children: n.children}
+}
+
+type Tester struct {
+	lock   sync.RWMutex
+	field1 string              `accessor:"getter,setter"`
+	field2 []int               `accessor:"getter,setter"`
+	field3 map[string][]string `accessor:"getter,setter"`
+	field4 *int                `accessor:"getter,setter"`
+	field5 [][]byte
+	field6 *Node
+	field7 [2]*int
+	field8 map[string]int
+	mu     sync.Mutex
+}
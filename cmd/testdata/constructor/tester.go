@@ -0,0 +1,20 @@
+package test
+
+import (
+	"errors"
+	"time"
+)
+
+type Tester struct {
+	field1 string    `accessor:"getter,required"`
+	field2 int32     `accessor:"getter,setter,default:8080"`
+	time   time.Time `accessor:"getter,required"`
+	t      *bool     `accessor:"getter,required"`
+}
+
+func (t *Tester) Validate() error {
+	if t.field1 == "" {
+		return errors.New("field1 must not be empty")
+	}
+	return nil
+}
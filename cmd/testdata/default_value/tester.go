@@ -0,0 +1,20 @@
+package test
+
+import (
+	"math"
+	"time"
+)
+
+type Tester struct {
+	field1 string        `accessor:"getter,default:\"default\""`
+	field2 int64         `accessor:"getter,setter,default:math.MaxInt32"`
+	field3 time.Time     `accessor:"getter,default:time.UnixMilli(0)"`
+	field4 time.Duration `accessor:"getter,noDefault,default:time.Second"`
+	field5 []string      `accessor:"getter"`
+	field6 []string      `accessor:"getter,default:[]string{\"a\", \"b\"}"`
+	field7 time.Time     `accessor:"getter,default:time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)"`
+}
+
+func (t *Tester) IsMax() bool {
+	return t.field2 == math.MaxInt64
+}
@@ -0,0 +1,22 @@
+package test
+
+import "sync"
+
+type Embedded struct{}
+
+// Tester is a struct whose fields have getters and setters by default.
+//
+//accessory:getter,setter
+type Tester struct {
+	Embedded
+	lock   sync.Mutex
+	dirty  map[string]struct{}
+	field1 string
+	field2 int    `json:"second"`
+	field3 bool   `accessor:"getter"`
+	field4 []byte `accessor:"-"`
+}
+
+type Other struct {
+	field1 string
+}
@@ -0,0 +1,14 @@
+package test
+
+import "sync"
+
+type Tester struct {
+	lock   sync.RWMutex
+	dirty  map[string]struct{}
+	field1 string  `accessor:"getter,setter"`
+	field2 int32   `accessor:"setter,onChange:field2Changed"`
+	field3 *bool   `accessor:"clear"`
+	field4 float64 `accessor:"swap,cas"`
+}
+
+func (t *Tester) field2Changed(old, new int32) {}
@@ -0,0 +1,19 @@
+package test
+
+import (
+	"sync"
+	"time"
+)
+
+type Tester struct {
+	lock   sync.RWMutex
+	field1 string            `accessor:"getter,setter"`
+	field2 []byte            `accessor:"getter,setter"`
+	field3 time.Time         `accessor:"getter,setter"`
+	field4 []int             `accessor:"getter,setter"`
+	field5 map[string]string `accessor:"getter,setter"`
+	field6 []time.Time       `accessor:"getter,setter"`
+	field7 func()            `accessor:"getter,noequal"`
+	field8 int
+	field9 map[string]time.Time `accessor:"getter,setter"`
+}
@@ -0,0 +1,13 @@
+package test
+
+type Tester struct {
+	//accessory:getter:GetFirstField,setter
+	field1 string `json:"field1" db:"field1" validate:"required,max=64"`
+	field2 int    `json:"field2" db:"field2"` //accessory:getter
+	// field3 is ignored.
+	//
+	//accessory:-
+	field3         bool
+	field4, field5 float64 //accessory:setter
+	field6         []byte  `accessor:"getter"`
+}
@@ -0,0 +1,12 @@
+package test
+
+type HTTPServer struct {
+	id             int    `accessor:"getter,setter"`
+	url            string `accessor:"getter,setter"`
+	httpClient     string `accessor:"getter"`
+	userId         int64  `accessor:"getter"`
+	jsonAPIVersion string `accessor:"getter"`
+	utf8Name       string `accessor:"getter"`
+	grpcConn       string `accessor:"getter,setter"`
+	name           string `accessor:"getter"`
+}
@@ -0,0 +1,15 @@
+package test
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Tester struct {
+	field1 string         `accessor:"getter,setter"`
+	field2 int32          `accessor:"getter:GetSecondField,swap,cas"`
+	field3 *time.Time     `accessor:"has,clear,orZero,or"`
+	field4 sql.NullString `accessor:"getter,setter,unwrap"`
+	field5 []string       `accessor:"setter,noDefault"`
+	field6 bool
+}
@@ -0,0 +1,26 @@
+package test
+
+import (
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+)
+
+type Tester struct {
+	lock   sync.RWMutex
+	field1 string         `accessor:"getter,setter"`
+	field2 int32          `accessor:"getter,setter,json:second,omitempty"`
+	field3 time.Time      `accessor:"getter" json:"third,omitempty"`
+	field4 sql.NullString `accessor:"getter,setter,unwrap" json:"fourth"`
+	field5 []string       `accessor:"setter"`
+	field6 string         `accessor:"getter,setter" json:"-"`
+	field7 string         `accessor:"getter,setter,json:-"`
+}
+
+func (t *Tester) Validate() error {
+	if t.field2 < 0 {
+		return errors.New("field2 must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+package test
+
+import "database/sql"
+
+type Vehicle struct {
+	name  string         `accessor:"getter,setter"`
+	model sql.NullString `accessor:"getter,setter,unwrap"`
+}
+
+func (v *Vehicle) Validate() error {
+	return nil
+}
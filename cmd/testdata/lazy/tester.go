@@ -0,0 +1,23 @@
+package test
+
+import (
+	"sync"
+	"time"
+)
+
+type Values map[string]int
+
+type Names = []string
+
+type Name = string
+
+type Tester struct {
+	lock   sync.RWMutex
+	field1 map[string]time.Time `accessor:"getter,lazy"`
+	field2 []int32              `accessor:"getter,setter,lazy"`
+	field3 *time.Location       `accessor:"getter:Location,lazy"`
+	field4 Values               `accessor:"getter,lazy"`
+	field5 string               `accessor:"getter"`
+	field6 Names                `accessor:"getter,lazy"`
+	field7 Name                 `accessor:"getter"`
+}
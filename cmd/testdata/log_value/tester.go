@@ -0,0 +1,24 @@
+package test
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+)
+
+type Credential struct {
+	user string
+}
+
+func (c Credential) LogValue() slog.Value {
+	return slog.StringValue(c.user)
+}
+
+type Tester struct {
+	lock   sync.RWMutex
+	field1 string     `accessor:"getter"`
+	field2 string     `accessor:"getter,secret"`
+	field3 Credential `accessor:"getter"`
+	field4 time.Time  `accessor:"setter"`
+	field5 int32
+}
@@ -0,0 +1,20 @@
+package test
+
+import (
+	"database/sql"
+	"sync"
+)
+
+type Tester struct {
+	lock   sync.Mutex
+	field1 string         `accessor:"getter,setter,onChange:field1Changed"`
+	field2 int32          `accessor:"setter,onChange:field2Changed,changedOnly"`
+	field3 sql.NullString `accessor:"setter,unwrap,onChange:field3Changed"`
+	field4 []string       `accessor:"setter"`
+}
+
+func (t *Tester) field1Changed(old, new string) {}
+
+func (t *Tester) field2Changed(old, new int32) {}
+
+func (t *Tester) field3Changed(old, new sql.NullString) {}
@@ -0,0 +1,14 @@
+package test
+
+import (
+	"sync"
+	"time"
+)
+
+type Tester struct {
+	lock   sync.RWMutex
+	field1 *string    `accessor:"getter,setter,has,clear,orZero,or"`
+	field2 *time.Time `accessor:"has:IsSecondFieldSet,clear:ResetSecondField,orZero:SecondField,or:SecondFieldOr"`
+	field3 *[]int32   `accessor:"orZero"`
+	field4 *bool
+}
@@ -0,0 +1,11 @@
+package test
+
+import "time"
+
+type Tester struct {
+	field1 string        `accessor:"getter,option"`
+	field2 int32         `accessor:"getter,option:WithSecondField,default:8080"`
+	field3 time.Duration `accessor:"option,default:time.Second"`
+	field4 []string      `accessor:"getter,setter"`
+	field5 []int         `accessor:"option,default:[]int{1, 2}"`
+}
@@ -0,0 +1,25 @@
+package test
+
+import (
+	"database/sql"
+	"errors"
+	"sync"
+)
+
+type Tester struct {
+	lock   sync.Mutex
+	dirty  map[string]struct{}
+	field1 string         `accessor:"getter,setter" json:"field1"`
+	field2 int            `accessor:"getter,setter,onChange:field2Changed,changedOnly"`
+	field3 sql.NullString `accessor:"getter,setter,unwrap"`
+	field4 []string       `accessor:"getter"`
+}
+
+func (t *Tester) field2Changed(old, new int) {}
+
+func (t *Tester) Validate() error {
+	if t.field1 == "" {
+		return errors.New("field1 is required")
+	}
+	return nil
+}
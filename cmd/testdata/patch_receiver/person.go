@@ -0,0 +1,19 @@
+package test
+
+import (
+	"errors"
+	"sync"
+)
+
+type Person struct {
+	mu   sync.RWMutex
+	name string `accessor:"getter,setter"`
+	age  int    `accessor:"getter,setter"`
+}
+
+func (p *Person) Validate() error {
+	if p.age < 0 {
+		return errors.New("age must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package test
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Tester struct {
+	field1 string         `accessor:"getter,setter"`
+	field2 int32          `accessor:"getter:GetSecondField"`
+	field3 time.Time      `accessor:"setter:ChangeThirdField"`
+	field4 sql.NullString `accessor:"getter,setter,unwrap"`
+	field5 *bool
+	field6 []string `accessor:"getter,setter"`
+}
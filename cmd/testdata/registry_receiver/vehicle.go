@@ -0,0 +1,9 @@
+package test
+
+import "database/sql"
+
+type Vehicle struct {
+	name   string         `accessor:"getter,setter"`
+	model  sql.NullString `accessor:"getter,setter,unwrap"`
+	owners []string       `accessor:"getter,setter"`
+}
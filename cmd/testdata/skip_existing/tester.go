@@ -0,0 +1,13 @@
+package test
+
+import "strings"
+
+type Tester struct {
+	field1 string `accessor:"getter,setter"`
+	field2 int    `accessor:"getter,setter"`
+}
+
+// Field1 is declared by hand, so it is not generated.
+func (t *Tester) Field1() string {
+	return strings.ToUpper(t.field1)
+}
@@ -0,0 +1,15 @@
+package test
+
+import (
+	"sync"
+	"time"
+)
+
+type State int
+
+type Tester struct {
+	lock   sync.Mutex
+	field1 State     `accessor:"getter,swap,cas"`
+	field2 time.Time `accessor:"swap:Replace,cas:Transition"`
+	field3 []string  `accessor:"swap"`
+}
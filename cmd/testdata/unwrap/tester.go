@@ -0,0 +1,17 @@
+package test
+
+import (
+	"database/sql"
+	"sync"
+)
+
+type NullInt = sql.NullInt64
+
+type Tester struct {
+	lock   sync.Mutex
+	field1 sql.NullString  `accessor:"getter,setter,orZero,unwrap"`
+	field2 NullInt         `accessor:"getter,setter,unwrap"`
+	field3 sql.NullTime    `accessor:"getter:CreatedAt,setter,has,clear,orZero,unwrap"`
+	field4 sql.Null[int32] `accessor:"getter,setter,or,unwrap"`
+	field5 sql.NullBool    `accessor:"getter"`
+}
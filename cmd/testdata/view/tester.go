@@ -0,0 +1,14 @@
+package test
+
+import (
+	"sync"
+	"time"
+)
+
+type Tester struct {
+	lock   sync.RWMutex
+	field1 string     `accessor:"getter,setter"`
+	field2 int32      `accessor:"getter:GetSecondField,setter"`
+	field3 *time.Time `accessor:"has,clear,or"`
+	field4 []string   `accessor:"setter"`
+}
@@ -0,0 +1,10 @@
+package test
+
+import "time"
+
+type Tester struct {
+	field1 string    `accessor:"getter,with"`
+	field2 int32     `accessor:"with:ChangeSecondField"`
+	field3 time.Time `accessor:"getter,setter,with"`
+	field4 *bool
+}
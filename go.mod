@@ -5,7 +5,6 @@ go 1.25.0
 require (
 	github.com/bradleyjkemp/cupaloy/v2 v2.8.0
 	github.com/spf13/afero v1.15.0
-	golang.org/x/text v0.37.0
 	golang.org/x/tools v0.45.0
 )
 
@@ -14,4 +13,5 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	golang.org/x/mod v0.36.0 // indirect
 	golang.org/x/sync v0.20.0 // indirect
+	golang.org/x/text v0.37.0 // indirect
 )
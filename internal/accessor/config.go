@@ -0,0 +1,28 @@
+package accessor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Config contains the configuration of accessory read from a JSON file.
+type Config struct {
+	// Initialisms are written in upper case in method names in addition to the common ones, e.g. "GRPC".
+	Initialisms []string `json:"initialisms"`
+}
+
+// LoadConfig reads the configuration from the JSON file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := new(Config)
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+
+	return config, nil
+}
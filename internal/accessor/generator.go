@@ -2,18 +2,19 @@ package accessor
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/parser"
 	"go/types"
+	"maps"
 	"path/filepath"
-	"regexp"
 	"slices"
 	"strings"
 	"text/template"
 
 	templates "github.com/masaushi/accessory/internal/accessor/gotemplates"
 	"github.com/spf13/afero"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 	"golang.org/x/tools/go/packages"
 )
 
@@ -24,24 +25,188 @@ type generator struct {
 	output   string
 	receiver string
 	lock     string
+	builder  bool
+	options  bool
+
+	constructor     bool
+	constructorName string
+
+	dirty     string // name of the field recording names of fields set by setters
+	registry  bool
+	json      bool
+	logValuer bool
+
+	iface      bool
+	readerName string
+	writerName string
+	fake       bool
+	view       bool
+	clone      bool
+	equal      bool
+	patch      bool
+
+	skipExisting    bool
+	initialisms     initialisms
+	userInitialisms []string
+	existing        map[string]struct{} // methods declared by hand, which are skipped
 
 	pkg     *packages.Package
 	imports []*Import
 
-	usedPackages map[string]struct{}
+	usedPackages     map[string]struct{}
+	requiredPackages map[string]struct{}
 }
 
 type methodGenParameters struct {
-	Receiver     string
-	Struct       string
-	Field        string
-	GetterMethod string
-	SetterMethod string
-	NoDefault    bool
-	Type         string
-	ZeroValue    string // used only when generating getter
-	Lock         string
-	LockType     LockType
+	Receiver      string
+	Struct        string
+	Field         string
+	GetterMethod  string
+	SetterMethod  string
+	HasGetter     bool
+	HasSetter     bool
+	JSONTag       string // struct tag of the field in JSON, or empty if the field is ignored
+	Secret        bool
+	LogValuer     bool // whether the field can be resolved by its LogValue method
+	WithMethod    string
+	BuilderMethod string
+	OptionFunc    string
+	Option        bool
+	Default       string
+	Param         string // used only when generating constructor
+	NoDefault     bool
+	Lazy          bool
+	Unwrap        bool
+	Required      bool
+	Type          string
+	ZeroValue     string // used only when generating getter
+	IsZero        string // expression reporting whether the field is zero; used only with default value
+	InitValue     string // used only when generating lazy getter
+	HasMethod     string
+	ClearMethod   string
+	OrZeroMethod  string
+	OrMethod      string
+	SwapMethod    string
+	CASMethod     string
+	ValueType     string // type of the value held by an optional field
+	ValueZero     string // zero value of ValueType
+	IsSet         string // expression reporting whether an optional field holds a value
+	IsUnset       string // negation of IsSet
+	SetterType    string // type of the setter parameter
+	SetterNil     bool   // whether nil can be passed to the setter
+	SetValue      string // expression assigned to the field by the setter
+	OnChange      string // method called after the field is changed by the setter
+	ChangedOnly   bool
+	Dirty         string
+	Value         string // expression obtaining the value held by an optional field
+	Lock          string
+	LockType      LockType
+}
+
+type typeGenParameters struct {
+	Package     string
+	Receiver    string
+	Struct      string
+	Lock        string
+	LockType    LockType
+	Validate    bool   // whether the struct has Validate() error method
+	Constructor string // used only when generating constructor
+	Dirty       string
+	Fields      []*methodGenParameters
+	Readers     []*methodSignature // accessor methods reading fields
+	Writers     []*methodSignature // accessor methods writing fields
+	Reader      string             // name of the interface of Readers
+	Writer      string             // name of the interface of Writers
+	CloneFields []*cloneField
+	Equals      []string // expressions comparing fields with those of other in Equal
+	Copies      []*Field // fields which can be copied, i.e. fields except locks and blank fields
+	BuilderSet  string   // field of the builder recording the required fields which are set
+}
+
+// cloneField contains a field copied by Clone method and statements deeply copying its value.
+type cloneField struct {
+	Name string
+	Copy string
+}
+
+// RequiredFields returns the fields marked as required.
+func (p *typeGenParameters) RequiredFields() []*methodGenParameters {
+	var fields []*methodGenParameters
+	for _, f := range p.Fields {
+		if f.Required {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// Getters returns the fields which have getters.
+func (p *typeGenParameters) Getters() []*methodGenParameters {
+	var fields []*methodGenParameters
+	for _, f := range p.Fields {
+		if f.HasGetter {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// Setters returns the fields which have setters.
+func (p *typeGenParameters) Setters() []*methodGenParameters {
+	var fields []*methodGenParameters
+	for _, f := range p.Fields {
+		if f.HasSetter {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// JSONGetters returns the fields which have getters and are encoded in JSON.
+func (p *typeGenParameters) JSONGetters() []*methodGenParameters {
+	var fields []*methodGenParameters
+	for _, f := range p.Getters() {
+		if f.JSONTag != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// JSONSetters returns the fields which have setters and are decoded from JSON.
+func (p *typeGenParameters) JSONSetters() []*methodGenParameters {
+	var fields []*methodGenParameters
+	for _, f := range p.Setters() {
+		if f.JSONTag != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// HasOnChange reports whether any of the fields which have setters has onChange method.
+func (p *typeGenParameters) HasOnChange() bool {
+	return slices.ContainsFunc(p.Setters(), func(f *methodGenParameters) bool { return f.OnChange != "" })
+}
+
+// HasRequired reports whether any of the fields is marked as required.
+func (p *typeGenParameters) HasRequired() bool {
+	return len(p.RequiredFields()) > 0
+}
+
+// accessorNames contains the names of the accessor methods generated for a field.
+type accessorNames struct {
+	Getter  string
+	Setter  string
+	With    string
+	Builder string
+	Option  string
+	Has     string
+	Clear   string
+	OrZero  string
+	Or      string
+	Swap    string
+	CAS     string
 }
 
 func newGenerator(fs afero.Fs, src *ParsedSource, options ...Option) *generator {
@@ -49,6 +214,7 @@ func newGenerator(fs afero.Fs, src *ParsedSource, options ...Option) *generator
 	for _, opt := range options {
 		opt(g)
 	}
+	g.initialisms = newInitialisms(g.userInitialisms...)
 
 	path := g.outputFilePath(src.Dir)
 	g.writer = newWriter(fs, path)
@@ -56,6 +222,7 @@ func newGenerator(fs afero.Fs, src *ParsedSource, options ...Option) *generator
 	g.pkg = src.Package
 	g.imports = src.Imports
 	g.usedPackages = make(map[string]struct{})
+	g.requiredPackages = make(map[string]struct{})
 
 	return g
 }
@@ -63,32 +230,58 @@ func newGenerator(fs afero.Fs, src *ParsedSource, options ...Option) *generator
 func Generate(fs afero.Fs, src *ParsedSource, options ...Option) error {
 	g := newGenerator(fs, src, options...)
 
+	// Fields used by generated codes don't have accessors even if the directive of the struct applies to them.
+	g.skipBookkeepingFields(src.Structs)
+
 	// Generate accessor methods for the specified type.
 	accessors, err := g.generateAccessors(src.Structs)
 	if err != nil {
 		return err
 	}
 
+	// Generate codes for the whole type, such as a builder.
+	codes, err := g.generateTypeCodes(src.Structs)
+	if err != nil {
+		return err
+	}
+
 	// Generate import statements for used packages.
 	imports := g.generateImports()
 
 	// Write the generated content to the file system.
-	return g.writer.write(src.Package.Name, imports, accessors)
+	if err := g.writer.write(src.Package.Name, imports, append(accessors, codes...)); err != nil {
+		return err
+	}
+
+	// Generate fakes of the interfaces into a separate test file.
+	if g.fake {
+		return g.generateFakes(fs, src)
+	}
+
+	return nil
+}
+
+// skipBookkeepingFields removes the tags given by the directive of the struct from the lock and dirty fields.
+func (g *generator) skipBookkeepingFields(structs []*Struct) {
+	for _, st := range structs {
+		if st.Name != g.typ {
+			continue
+		}
+		for _, field := range st.Fields {
+			if field.Directive && (field.Name == g.lock || field.Name == g.dirty) {
+				field.Tag = nil
+			}
+		}
+	}
 }
 
 func (g *generator) outputFilePath(dir string) string {
 	output := g.output
 	// If output file path is not specified, use snake_case name of the type as output file.
 	if output == "" {
-		// Convert the first letter of the type to lowercase and replace all uppercase letters
-		// followed by lowercase letters with the lowercase letter preceded by an underscore.
-		// For example, "TestStruct" becomes "test_struct".
-		firstCapMatcher := regexp.MustCompile("(.)([A-Z][a-z]+)")
-		articleCapMatcher := regexp.MustCompile("([a-z0-9])([A-Z])")
-
-		name := firstCapMatcher.ReplaceAllString(g.typ, "${1}_${2}")
-		name = articleCapMatcher.ReplaceAllString(name, "${1}_${2}")
-		output = strings.ToLower(fmt.Sprintf("%s_accessor.go", name))
+		// Convert the words of the type to lowercase joined by underscores, keeping initialisms together.
+		// For example, "TestStruct" becomes "test_struct" and "HTTPServer" becomes "http_server".
+		output = fmt.Sprintf("%s_accessor.go", g.initialisms.snakeName(g.typ))
 	}
 
 	return filepath.Join(dir, output)
@@ -111,9 +304,34 @@ func (g *generator) generateImports() []string {
 		importStrings = append(importStrings, importString)
 	}
 
+	// Packages not imported in the source, e.g. time for sql.NullTime, are imported without a name.
+	for path := range g.usedPackages {
+		if path == "" || path == g.pkg.PkgPath {
+			continue
+		}
+		if !slices.ContainsFunc(g.imports, func(imp *Import) bool { return imp.Path == path }) {
+			g.requirePackage(path)
+		}
+	}
+
+	required := slices.Sorted(maps.Keys(g.requiredPackages))
+	for _, path := range required {
+		importString := fmt.Sprintf("%q", path)
+		// Skip if the package is already imported without a name.
+		if slices.Contains(importStrings, importString) {
+			continue
+		}
+		importStrings = append(importStrings, importString)
+	}
+
 	return importStrings
 }
 
+// requirePackage marks a package as required by the generated codes themselves, e.g. fmt.
+func (g *generator) requirePackage(path string) {
+	g.requiredPackages[path] = struct{}{}
+}
+
 func (g *generator) generateAccessors(structs []*Struct) ([]string, error) {
 	accessors := make([]string, 0)
 
@@ -123,49 +341,454 @@ func (g *generator) generateAccessors(structs []*Struct) ([]string, error) {
 			continue
 		}
 
+		if err := g.checkNameCollisions(st); err != nil {
+			return nil, err
+		}
+		if err := g.checkConflicts(st); err != nil {
+			return nil, err
+		}
+		if g.dirty != "" {
+			if err := g.checkDirtyField(st); err != nil {
+				return nil, err
+			}
+		}
+
 		for _, field := range st.Fields {
 			if field.Tag == nil {
 				continue
 			}
 
+			if field.Tag.Default != nil {
+				if err := g.checkDefaultValue(st, field); err != nil {
+					return nil, err
+				}
+			}
+
+			if field.Tag.Lazy {
+				if err := g.checkLazy(field); err != nil {
+					return nil, err
+				}
+			}
+
 			params := g.createMethodGenParameters(st, field)
 
-			if field.Tag.Getter != nil {
+			if field.Tag.hasOptionalMethods() && params.ValueType == "" {
+				return nil, fmt.Errorf(
+					"%s: has, clear, orZero and or can't be used for field %s: "+
+						"type %s is neither a pointer nor a Null type of database/sql",
+					g.pkg.Fset.Position(field.Pos), field.Name, params.Type)
+			}
+
+			if field.Tag.Unwrap {
+				if err := g.checkUnwrap(field); err != nil {
+					return nil, err
+				}
+			}
+
+			if field.Tag.CAS != nil && !types.Comparable(field.Type) {
+				return nil, fmt.Errorf("%s: cas can't be used for field %s: type %s is not comparable",
+					g.pkg.Fset.Position(field.Pos), field.Name, params.Type)
+			}
+
+			if field.Tag.OnChange != nil {
+				if err := g.checkOnChange(st, field); err != nil {
+					return nil, err
+				}
+			}
+
+			if field.Tag.Getter != nil && !g.isExisting(params.GetterMethod) {
 				getter, err := g.generateGetter(params)
 				if err != nil {
 					return nil, err
 				}
 				accessors = append(accessors, getter)
 			}
-			if field.Tag.Setter != nil {
+			if field.Tag.Setter != nil && !g.isExisting(params.SetterMethod) {
 				setter, err := g.generateSetter(params)
 				if err != nil {
 					return nil, err
 				}
 				accessors = append(accessors, setter)
 			}
+			if field.Tag.With != nil && !g.isExisting(params.WithMethod) {
+				// With methods copy the struct by value, which must not happen to a lock.
+				if containsLock(st.Type) || g.lock != "" {
+					return nil, fmt.Errorf(
+						"%s: cannot generate %s for field %s: struct %s contains a lock",
+						g.pkg.Fset.Position(field.Pos), params.WithMethod, field.Name, st.Name)
+				}
+				with, err := g.generateWith(params)
+				if err != nil {
+					return nil, err
+				}
+				accessors = append(accessors, with)
+			}
+			methods := []struct {
+				specified *string
+				method    string
+				name      string
+				tmpl      string
+			}{
+				{field.Tag.Has, params.HasMethod, "has", templates.Has},
+				{field.Tag.Clear, params.ClearMethod, "clear", templates.Clear},
+				{field.Tag.OrZero, params.OrZeroMethod, "orZero", templates.OrZero},
+				{field.Tag.Or, params.OrMethod, "or", templates.Or},
+				{field.Tag.Swap, params.SwapMethod, "swap", templates.Swap},
+				{field.Tag.CAS, params.CASMethod, "cas", templates.CompareAndSwap},
+			}
+			for _, m := range methods {
+				if m.specified == nil || g.isExisting(m.method) {
+					continue
+				}
+				method, err := g.execute(m.name, m.tmpl, params)
+				if err != nil {
+					return nil, err
+				}
+				accessors = append(accessors, method)
+			}
 
-			usedPackage := g.getUsedPackages(field)
-			g.usedPackages[usedPackage] = struct{}{}
+			for _, usedPackage := range g.getUsedPackages(field.Type) {
+				g.usedPackages[usedPackage] = struct{}{}
+			}
+			if nullValue := nullValueField(field.Type); nullValue != nil &&
+				(field.Tag.Unwrap || field.Tag.hasOptionalMethods()) {
+				for _, usedPackage := range g.getUsedPackages(nullValue.Type()) {
+					g.usedPackages[usedPackage] = struct{}{}
+				}
+			}
 		}
 	}
 
 	return accessors, nil
 }
 
-func (g *generator) generateSetter(
-	params *methodGenParameters,
+func (g *generator) generateTypeCodes(structs []*Struct) ([]string, error) {
+	codes := make([]string, 0)
+
+	for _, st := range structs {
+		// Check if the struct name matches the type name of the generator.
+		if st.Name != g.typ {
+			continue
+		}
+
+		params := g.createTypeGenParameters(st)
+
+		if g.dirty != "" {
+			dirty, err := g.generateDirty(params)
+			if err != nil {
+				return nil, err
+			}
+			codes = append(codes, dirty)
+		}
+		if g.registry {
+			registry, err := g.generateRegistry(params)
+			if err != nil {
+				return nil, err
+			}
+			codes = append(codes, registry)
+		}
+		if g.json {
+			json, err := g.generateJSON(params)
+			if err != nil {
+				return nil, err
+			}
+			codes = append(codes, json)
+		}
+		if g.logValuer {
+			logValue, err := g.generateLogValue(params)
+			if err != nil {
+				return nil, err
+			}
+			codes = append(codes, logValue)
+		}
+		if g.iface || g.fake {
+			iface, err := g.generateInterfaces(params)
+			if err != nil {
+				return nil, err
+			}
+			codes = append(codes, iface)
+		}
+		if g.clone {
+			clone, err := g.generateClone(params)
+			if err != nil {
+				return nil, err
+			}
+			codes = append(codes, clone)
+		}
+		if g.equal {
+			equal, err := g.generateEqual(st, params)
+			if err != nil {
+				return nil, err
+			}
+			codes = append(codes, equal)
+		}
+		if g.patch {
+			patch, err := g.execute("patch", templates.Patch, params)
+			if err != nil {
+				return nil, err
+			}
+			codes = append(codes, patch)
+		}
+		if g.view {
+			view, err := g.execute("view", templates.View, params)
+			if err != nil {
+				return nil, err
+			}
+			codes = append(codes, view)
+		}
+		if g.builder {
+			builder, err := g.generateBuilder(params)
+			if err != nil {
+				return nil, err
+			}
+			codes = append(codes, builder)
+		}
+		if g.options {
+			options, err := g.generateOptions(params)
+			if err != nil {
+				return nil, err
+			}
+			codes = append(codes, options)
+		}
+		// Constructor is generated last,
+		// so that its parameters can avoid names of all packages imported by the generated file.
+		if g.constructor {
+			constructor, err := g.generateConstructor(params)
+			if err != nil {
+				return nil, err
+			}
+			codes = append(codes, constructor)
+		}
+	}
+
+	return codes, nil
+}
+
+func (g *generator) generateDirty(
+	params *typeGenParameters,
+) (string, error) {
+	g.requirePackage("slices")
+
+	return g.execute("dirty", templates.Dirty, params)
+}
+
+func (g *generator) generateRegistry(
+	params *typeGenParameters,
+) (string, error) {
+	g.requirePackage("fmt")
+
+	return g.execute("registry", templates.Registry, params)
+}
+
+func (g *generator) generateJSON(
+	params *typeGenParameters,
+) (string, error) {
+	g.requirePackage("encoding/json")
+
+	return g.execute("json", templates.JSON, params)
+}
+
+func (g *generator) generateLogValue(
+	params *typeGenParameters,
+) (string, error) {
+	g.requirePackage("fmt")
+	g.requirePackage("log/slog")
+
+	return g.execute("logValue", templates.LogValue, params)
+}
+
+func (g *generator) generateClone(
+	params *typeGenParameters,
+) (string, error) {
+	for _, field := range params.Copies {
+		params.CloneFields = append(params.CloneFields, &cloneField{
+			Name: field.Name,
+			Copy: strings.TrimSuffix(
+				g.cloneValue("cloned."+field.Name, params.Receiver+"."+field.Name, field.Type, 0), "\n"),
+		})
+	}
+
+	return g.execute("clone", templates.Clone, params)
+}
+
+func (g *generator) generateEqual(
+	st *Struct,
+	params *typeGenParameters,
+) (string, error) {
+	for _, field := range st.Fields {
+		if field.Tag == nil || field.Tag.NoEqual {
+			continue
+		}
+
+		a, b := params.Receiver+"."+field.Name, "other."+field.Name
+		equal := g.equalExpr(a, b, field.Type)
+		if equal == "" {
+			return "", fmt.Errorf("%s: field %s can't be compared in Equal: type %s is not comparable; exclude it with noequal",
+				g.pkg.Fset.Position(field.Pos), field.Name, g.typeName(field.Type))
+		}
+		params.Equals = append(params.Equals, equal)
+	}
+	if g.lock != "" {
+		// Locks are obtained in the order of the addresses.
+		g.requirePackage("unsafe")
+	}
+
+	return g.execute("equal", templates.Equal, params)
+}
+
+func (g *generator) generateInterfaces(
+	params *typeGenParameters,
+) (string, error) {
+	return g.execute("interface", templates.Interface, params)
+}
+
+// generateFakes writes fakes of the interfaces of accessor methods into a test file
+// placed next to the output file, e.g. tester_accessor_fake_test.go.
+func (g *generator) generateFakes(fs afero.Fs, src *ParsedSource) error {
+	for _, st := range src.Structs {
+		if st.Name != g.typ {
+			continue
+		}
+
+		params := g.createTypeGenParameters(st)
+		fake, err := g.execute("fake", templates.Fake, params)
+		if err != nil {
+			return err
+		}
+
+		output := strings.TrimSuffix(g.outputFilePath(src.Dir), ".go") + "_fake_test.go"
+		return newWriter(fs, output).write(src.Package.Name, g.fakeImports(params), []string{fake})
+	}
+
+	return nil
+}
+
+// fakeImports returns import statements for the packages used by the signatures of the fakes.
+func (g *generator) fakeImports(params *typeGenParameters) []string {
+	used := map[string]struct{}{"sync": {}}
+	for _, m := range slices.Concat(params.Readers, params.Writers) {
+		for _, path := range m.packages {
+			if path != "" && path != g.pkg.PkgPath {
+				used[path] = struct{}{}
+			}
+		}
+	}
+
+	importStrings := make([]string, 0, len(used))
+	for _, path := range slices.Sorted(maps.Keys(used)) {
+		importString := fmt.Sprintf("%q", path)
+		for _, imp := range g.imports {
+			if imp.Path == path && imp.IsNamed {
+				// If the import is named, add the name before the path.
+				importString = imp.Name + " " + importString
+			}
+		}
+		importStrings = append(importStrings, importString)
+	}
+
+	return importStrings
+}
+
+func (g *generator) generateBuilder(
+	params *typeGenParameters,
 ) (string, error) {
-	t := template.Must(template.New("setter").Parse(templates.Setter))
+	if params.HasRequired() {
+		g.requirePackage("fmt")
+		g.requirePackage("strings")
+	}
+
+	// The field recording the required fields must not collide with the fields copied to the builder.
+	used := make(map[string]struct{})
+	for _, f := range params.Fields {
+		used[f.Field] = struct{}{}
+	}
+	params.BuilderSet = "set"
+	for i := 2; ; i++ {
+		if _, ok := used[params.BuilderSet]; !ok {
+			break
+		}
+		params.BuilderSet = fmt.Sprintf("set%d", i)
+	}
+
+	return g.execute("builder", templates.Builder, params)
+}
+
+func (g *generator) generateOptions(
+	params *typeGenParameters,
+) (string, error) {
+	return g.execute("options", templates.Options, params)
+}
+
+func (g *generator) generateConstructor(
+	params *typeGenParameters,
+) (string, error) {
+	params.Constructor = g.constructorFunc(params.Struct)
+	if g.options && params.Constructor == "New"+params.Struct {
+		return "", fmt.Errorf(
+			"constructor name %s is also used with functional options; specify another name",
+			params.Constructor)
+	}
+
+	// Parameter names must not shadow packages and local variables used in the constructor.
+	used := g.packageNames()
+	used[params.Receiver] = struct{}{}
+	used["err"] = struct{}{}
+	for _, f := range params.RequiredFields() {
+		f.Param = f.Field
+		for i := 1; ; i++ {
+			if _, ok := used[f.Param]; !ok {
+				break
+			}
+			f.Param = fmt.Sprintf("%sVal", f.Field)
+			if i > 1 {
+				f.Param = fmt.Sprintf("%sVal%d", f.Field, i)
+			}
+		}
+		used[f.Param] = struct{}{}
+	}
+
+	return g.execute("constructor", templates.Constructor, params)
+}
+
+// execute executes the template with the data.
+// Templates obtaining and releasing the lock and marking the field as dirty are available in the template.
+func (g *generator) execute(name, tmpl string, data any) (string, error) {
+	t := template.Must(template.New(name).Parse(templates.Lock))
+	t = template.Must(t.Parse(templates.MarkDirty))
+	t = template.Must(t.Parse(tmpl))
 	buf := new(bytes.Buffer)
 
-	if err := t.Execute(buf, params); err != nil {
+	if err := t.Execute(buf, data); err != nil {
 		return "", err
 	}
 
 	return buf.String(), nil
 }
 
+// packageNames returns names of the packages which can be imported by the generated file.
+func (g *generator) packageNames() map[string]struct{} {
+	names := make(map[string]struct{})
+	for _, imp := range g.imports {
+		names[imp.Name] = struct{}{}
+	}
+	for path := range g.requiredPackages {
+		names[filepath.Base(path)] = struct{}{}
+	}
+	return names
+}
+
+func (g *generator) generateSetter(
+	params *methodGenParameters,
+) (string, error) {
+	return g.execute("setter", templates.Setter, params)
+}
+
+func (g *generator) generateWith(
+	params *methodGenParameters,
+) (string, error) {
+	return g.execute("with", templates.With, params)
+}
+
 func (g *generator) generateGetter(
 	params *methodGenParameters,
 ) (string, error) {
@@ -174,42 +797,377 @@ func (g *generator) generateGetter(
 	if params.NoDefault {
 		tmpl = templates.GetterNoDefault
 	}
-
-	t := template.Must(template.New("getter").Parse(tmpl))
-	buf := new(bytes.Buffer)
-
-	if err := t.Execute(buf, params); err != nil {
-		return "", err
+	if params.Lazy {
+		tmpl = templates.GetterLazy
+	}
+	if params.Unwrap {
+		tmpl = templates.GetterUnwrap
 	}
 
-	return buf.String(), nil
+	return g.execute("getter", tmpl, params)
 }
 
 func (g *generator) createMethodGenParameters(st *Struct, field *Field) *methodGenParameters {
 	typeName := g.typeName(field.Type)
-	getter, setter := g.methodNames(field)
+	names := g.methodNames(field)
+
+	receiver := g.receiverName(st.Name)
+
+	var defaultValue, isZero string
+	if field.Tag.Default != nil {
+		defaultValue = *field.Tag.Default
+		isZero = g.isZeroExpr(receiver+"."+field.Name, field.Type, typeName)
+	}
+
+	// Pointer fields are optional values which may hold a value.
+	var valueType, valueZero, isSet, isUnset, value string
+	if ptr, ok := field.Type.Underlying().(*types.Pointer); ok {
+		valueType = g.typeName(ptr.Elem())
+		valueZero = g.zeroValue(ptr.Elem(), valueType)
+		isSet = fmt.Sprintf("%s.%s != nil", receiver, field.Name)
+		isUnset = fmt.Sprintf("%s.%s == nil", receiver, field.Name)
+		value = fmt.Sprintf("*%s.%s", receiver, field.Name)
+	}
+	setterType, setValue, setterNil := typeName, "val", isNillable(field.Type)
+	// Null types of database/sql, such as sql.NullString, are also optional values.
+	if nullValue := nullValueField(field.Type); nullValue != nil {
+		valueType = g.typeName(nullValue.Type())
+		valueZero = g.zeroValue(nullValue.Type(), valueType)
+		isSet = fmt.Sprintf("%s.%s.Valid", receiver, field.Name)
+		isUnset = fmt.Sprintf("!%s.%s.Valid", receiver, field.Name)
+		value = fmt.Sprintf("%s.%s.%s", receiver, field.Name, nullValue.Name())
+		if field.Tag.Unwrap {
+			// Setter takes the value and makes it valid.
+			setterType = valueType
+			setterNil = isNillable(nullValue.Type())
+			setValue = fmt.Sprintf("%s{%s: val, Valid: true}", typeName, nullValue.Name())
+		}
+	}
+
+	var onChange string
+	if field.Tag.OnChange != nil {
+		onChange = *field.Tag.OnChange
+	}
+
 	return &methodGenParameters{
-		Receiver:     g.receiverName(st.Name),
-		Struct:       st.Name,
-		Field:        field.Name,
-		GetterMethod: getter,
-		SetterMethod: setter,
-		NoDefault:    field.Tag.NoDefault,
-		Type:         typeName,
-		ZeroValue:    g.zeroValue(field.Type, typeName),
-		Lock:         g.lock,
-		LockType:     st.LockType,
-	}
-}
-
-func (g *generator) getUsedPackages(field *Field) string {
-	var typePackage string
-	types.TypeString(field.Type, func(p *types.Package) string {
-		typePackage = p.Path()
+		Receiver:      receiver,
+		Struct:        st.Name,
+		Field:         field.Name,
+		GetterMethod:  names.Getter,
+		SetterMethod:  names.Setter,
+		HasGetter:     field.Tag.Getter != nil,
+		HasSetter:     field.Tag.Setter != nil,
+		JSONTag:       jsonStructTag(field),
+		Secret:        field.Tag.Secret,
+		LogValuer:     isLogValuer(field.Type),
+		WithMethod:    names.With,
+		BuilderMethod: names.Builder,
+		OptionFunc:    names.Option,
+		Option:        field.Tag.Option != nil,
+		Default:       defaultValue,
+		NoDefault:     field.Tag.NoDefault,
+		Lazy:          field.Tag.Lazy,
+		Unwrap:        field.Tag.Unwrap,
+		Required:      field.Tag.Required,
+		Type:          typeName,
+		ZeroValue:     g.zeroValue(field.Type, typeName),
+		IsZero:        isZero,
+		InitValue:     g.initValue(field.Type, typeName),
+		HasMethod:     names.Has,
+		ClearMethod:   names.Clear,
+		OrZeroMethod:  names.OrZero,
+		OrMethod:      names.Or,
+		SwapMethod:    names.Swap,
+		CASMethod:     names.CAS,
+		ValueType:     valueType,
+		ValueZero:     valueZero,
+		IsSet:         isSet,
+		IsUnset:       isUnset,
+		SetterType:    setterType,
+		SetterNil:     setterNil,
+		SetValue:      setValue,
+		OnChange:      onChange,
+		ChangedOnly:   field.Tag.ChangedOnly,
+		Dirty:         g.dirty,
+		Value:         value,
+		Lock:          g.lock,
+		LockType:      st.LockType,
+	}
+}
+
+func (g *generator) createTypeGenParameters(st *Struct) *typeGenParameters {
+	fields := make([]*methodGenParameters, 0, len(st.Fields))
+	var readers, writers []*methodSignature
+	for _, field := range st.Fields {
+		if field.Tag == nil {
+			continue
+		}
+		params := g.createMethodGenParameters(st, field)
+		fields = append(fields, params)
+
+		r, w := g.accessorMethods(field, params)
+		readers = append(readers, r...)
+		writers = append(writers, w...)
+	}
+
+	var copies []*Field
+	for _, field := range st.Fields {
+		// Locks must not be copied, and blank fields can't be.
+		if isLock(field.Type) || field.Name == "_" {
+			continue
+		}
+		copies = append(copies, field)
+	}
+
+	reader, writer := g.interfaceNames(st.Name)
+
+	return &typeGenParameters{
+		Reader:   reader,
+		Writer:   writer,
+		Package:  g.pkg.Name,
+		Receiver: g.receiverName(st.Name),
+		Struct:   st.Name,
+		Lock:     g.lock,
+		LockType: st.LockType,
+		Validate: hasValidateMethod(st),
+		Dirty:    g.dirty,
+		Fields:   fields,
+		Readers:  readers,
+		Writers:  writers,
+		Copies:   copies,
+	}
+}
+
+// constructorFunc returns the name of the constructor of the struct.
+func (g *generator) constructorFunc(structName string) string {
+	if g.constructorName != "" {
+		return g.constructorName
+	}
+	return "New" + structName
+}
+
+// interfaceNames returns the names of the interfaces of accessor methods reading and writing fields.
+func (g *generator) interfaceNames(structName string) (reader, writer string) {
+	reader, writer = g.readerName, g.writerName
+	if reader == "" {
+		reader = structName + "Reader"
+	}
+	if writer == "" {
+		writer = structName + "Writer"
+	}
+	return reader, writer
+}
+
+// jsonStructTag returns the struct tag used to encode the field in JSON,
+// or an empty string if the field is ignored.
+func jsonStructTag(field *Field) string {
+	name := field.Name
+	if field.Tag.JSON != nil {
+		if *field.Tag.JSON == ignoreTag {
+			return ""
+		}
+		if *field.Tag.JSON != "" {
+			name = *field.Tag.JSON
+		}
+	}
+	if field.Tag.OmitEmpty {
+		name += "," + jsonTagOmitEmpty
+	}
+
+	return fmt.Sprintf("`%s:%q`", jsonTag, name)
+}
+
+// isLogValuer reports whether a value of type t has "LogValue() slog.Value" method which can be called safely.
+// Pointers are excluded since they may be nil.
+func isLogValuer(t types.Type) bool {
+	if isNillable(t) {
+		return false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, "LogValue")
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Params().Len() != 0 || sig.Results().Len() != 1 {
+		return false
+	}
+	named, ok := sig.Results().At(0).Type().(*types.Named)
+	return ok && named.Obj().Pkg() != nil &&
+		named.Obj().Pkg().Path() == "log/slog" && named.Obj().Name() == "Value"
+}
+
+// hasValidateMethod reports whether the struct has "Validate() error" method.
+// The method is called by generated codes building a value of the struct.
+func hasValidateMethod(st *Struct) bool {
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(st.Type), false, nil, "Validate")
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	return sig.Params().Len() == 0 && sig.Results().Len() == 1 &&
+		types.Identical(sig.Results().At(0).Type(), types.Universe.Lookup("error").Type())
+}
+
+// checkDefaultValue type-checks the default value of the field specified in the tag,
+// and marks packages referred by the default value as used.
+func (g *generator) checkDefaultValue(st *Struct, field *Field) error {
+	value := *field.Tag.Default
+	position := g.pkg.Fset.Position(field.Pos)
+
+	if !types.Comparable(field.Type) && !isNillable(field.Type) {
+		return fmt.Errorf("%s: default value can't be used for field %s: type %s is not comparable",
+			position, field.Name, g.typeName(field.Type))
+	}
+
+	// Evaluate an assignment of the default value to the field in the scope of the field declaration,
+	// so that packages imported in the file can be referred by the default value.
+	expr := fmt.Sprintf("func(v %s) { v.%s = %s }", st.Name, field.Name, value)
+	if _, err := types.Eval(g.pkg.Fset, g.pkg.Types, field.Pos, expr); err != nil {
+		var typeErr types.Error
+		if errors.As(err, &typeErr) {
+			err = errors.New(typeErr.Msg)
+		}
+		return fmt.Errorf("%s: invalid default value for field %s: %w", position, field.Name, err)
+	}
+
+	parsed, err := parser.ParseExpr(value)
+	if err != nil {
+		return err
+	}
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if ident, ok := sel.X.(*ast.Ident); ok {
+			for _, imp := range g.imports {
+				if imp.Name == ident.Name {
+					g.usedPackages[imp.Path] = struct{}{}
+				}
+			}
+		}
+		return true
+	})
+
+	return nil
+}
+
+// checkLazy checks that the field can be initialised by a lazy getter.
+func (g *generator) checkLazy(field *Field) error {
+	position := g.pkg.Fset.Position(field.Pos)
+
+	if field.Tag.NoDefault || field.Tag.Default != nil {
+		return fmt.Errorf("%s: lazy can't be used with noDefault or default for field %s",
+			position, field.Name)
+	}
+	if g.initValue(field.Type, g.typeName(field.Type)) == "" {
+		return fmt.Errorf("%s: lazy can't be used for field %s: type %s is not a map, slice or pointer",
+			position, field.Name, g.typeName(field.Type))
+	}
+
+	return nil
+}
+
+// checkUnwrap checks that the field holds a Null type of database/sql which can be unwrapped.
+func (g *generator) checkUnwrap(field *Field) error {
+	position := g.pkg.Fset.Position(field.Pos)
+
+	if field.Tag.NoDefault || field.Tag.Default != nil || field.Tag.Lazy {
+		return fmt.Errorf("%s: unwrap can't be used with noDefault, default or lazy for field %s",
+			position, field.Name)
+	}
+	if nullValueField(field.Type) == nil {
+		return fmt.Errorf("%s: unwrap can't be used for field %s: type %s is not a Null type of database/sql",
+			position, field.Name, g.typeName(field.Type))
+	}
+
+	return nil
+}
+
+// checkDirtyField checks that the field specified to record dirty fields is declared in the struct.
+func (g *generator) checkDirtyField(st *Struct) error {
+	idx := slices.IndexFunc(st.Fields, func(f *Field) bool { return f.Name == g.dirty })
+	if idx == -1 {
+		return fmt.Errorf("field %s to record dirty fields is not found in %s", g.dirty, st.Name)
+	}
+
+	field := st.Fields[idx]
+	dirtyType := types.NewMap(types.Typ[types.String], types.NewStruct(nil, nil))
+	if !types.Identical(field.Type, dirtyType) {
+		return fmt.Errorf("%s: field %s to record dirty fields must be %s, but %s",
+			g.pkg.Fset.Position(field.Pos), field.Name, dirtyType, g.typeName(field.Type))
+	}
+
+	return nil
+}
+
+// checkOnChange checks that the method specified by onChange can be called with the old and new values.
+func (g *generator) checkOnChange(st *Struct, field *Field) error {
+	position := g.pkg.Fset.Position(field.Pos)
+	name := *field.Tag.OnChange
+
+	if field.Tag.ChangedOnly && !types.Comparable(field.Type) {
+		return fmt.Errorf("%s: changedOnly can't be used for field %s: type %s is not comparable",
+			position, field.Name, g.typeName(field.Type))
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(st.Type), false, g.pkg.Types, name)
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return fmt.Errorf("%s: onChange method %s for field %s is not found in %s",
+			position, name, field.Name, st.Name)
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Params().Len() != 2 || sig.Results().Len() != 0 || sig.Variadic() ||
+		!types.Identical(sig.Params().At(0).Type(), field.Type) ||
+		!types.Identical(sig.Params().At(1).Type(), field.Type) {
+		return fmt.Errorf("%s: onChange method %s for field %s must be func(old, new %s), but %s",
+			position, name, field.Name, g.typeName(field.Type), types.TypeString(sig, g.qualifier))
+	}
+
+	return nil
+}
+
+// nullValueField returns the field holding the value of a Null type of database/sql,
+// e.g. String of sql.NullString and V of sql.Null[T], or nil if t is not a Null type.
+func nullValueField(t types.Type) *types.Var {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return nil
+	}
+
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != "database/sql" || !strings.HasPrefix(obj.Name(), "Null") {
+		return nil
+	}
+
+	// Null types hold the value in the first field and its validity in the second field.
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok || st.NumFields() != 2 {
+		return nil
+	}
+	valid := st.Field(1)
+	if valid.Name() != "Valid" || !types.Identical(valid.Type(), types.Typ[types.Bool]) {
+		return nil
+	}
+
+	return st.Field(0)
+}
+
+func (g *generator) getUsedPackages(t types.Type) []string {
+	var typePackages []string
+	types.TypeString(t, func(p *types.Package) string {
+		typePackages = append(typePackages, p.Path())
 		return ""
 	})
 
-	return typePackage
+	return typePackages
 }
 
 func (g *generator) receiverName(structName string) string {
@@ -222,52 +1180,440 @@ func (g *generator) receiverName(structName string) string {
 	return strings.ToLower(string(structName[0]))
 }
 
-func (g *generator) methodNames(field *Field) (getter, setter string) {
-	if getterName := field.Tag.Getter; getterName != nil && *getterName != "" {
-		getter = *getterName
-	} else {
-		// If no getter name is specified in the tag,
-		// use the field name capitalized as the getter name.
-		getter = cases.Title(language.Und, cases.NoLower).String(field.Name)
+func (g *generator) methodNames(field *Field) *accessorNames {
+	return &accessorNames{
+		// Getter is the field name capitalized, following the convention of Go.
+		Getter: g.methodName(field.Tag.Getter, "%s", field.Name),
+		Setter: g.methodName(field.Tag.Setter, "Set%s", field.Name),
+		With:   g.methodName(field.Tag.With, "With%s", field.Name),
+		// Methods of builders are always named after the field.
+		Builder: g.methodName(nil, "%s", field.Name),
+		Option:  g.methodName(field.Tag.Option, "With%s", field.Name),
+		Has:     g.methodName(field.Tag.Has, "Has%s", field.Name),
+		Clear:   g.methodName(field.Tag.Clear, "Clear%s", field.Name),
+		OrZero:  g.methodName(field.Tag.OrZero, "%sOrZero", field.Name),
+		Or:      g.methodName(field.Tag.Or, "%sOr", field.Name),
+		Swap:    g.methodName(field.Tag.Swap, "Swap%s", field.Name),
+		CAS:     g.methodName(field.Tag.CAS, "CompareAndSwap%s", field.Name),
 	}
+}
 
-	if setterName := field.Tag.Setter; setterName != nil && *setterName != "" {
-		setter = *setterName
-	} else {
-		// If no setter name is specified in the tag,
-		// use "Set" concatenated with the field name capitalized as the setter name.
-		setter = "Set" + cases.Title(language.Und, cases.NoLower).String(field.Name)
+// fieldMethods returns the names of the methods of the struct generated for the field.
+func (g *generator) fieldMethods(field *Field) []string {
+	names := g.methodNames(field)
+	tag := field.Tag
+
+	var methods []string
+	for _, m := range []struct {
+		specified *string
+		name      string
+	}{
+		{tag.Getter, names.Getter},
+		{tag.Setter, names.Setter},
+		{tag.With, names.With},
+		{tag.Has, names.Has},
+		{tag.Clear, names.Clear},
+		{tag.OrZero, names.OrZero},
+		{tag.Or, names.Or},
+		{tag.Swap, names.Swap},
+		{tag.CAS, names.CAS},
+	} {
+		if m.specified != nil {
+			methods = append(methods, m.name)
+		}
 	}
 
-	return getter, setter
+	return methods
 }
 
-func (g *generator) typeName(t types.Type) string {
-	return types.TypeString(t, func(p *types.Package) string {
-		// type is defined in the same package
-		if g.pkg.Types == p {
-			return "" // return an empty string
+// checkNameCollisions checks that the methods generated for the fields have distinct names,
+// which are also different from the methods generated for the whole struct.
+func (g *generator) checkNameCollisions(st *Struct) error {
+	typeMethods := g.typeMethods()
+	fields := make(map[string]*Field)
+	for _, field := range st.Fields {
+		if field.Tag == nil {
+			continue
 		}
 
-		idx := slices.IndexFunc(g.imports, func(imp *Import) bool {
-			return imp.Path == p.Path()
-		})
+		for _, method := range g.fieldMethods(field) {
+			if slices.Contains(typeMethods, method) {
+				return fmt.Errorf("%s: method %s of field %s collides with the method generated for struct %s",
+					g.pkg.Fset.Position(field.Pos), method, field.Name, st.Name)
+			}
+			if other, ok := fields[method]; ok {
+				return fmt.Errorf("%s: method %s of field %s collides with the method of field %s at %s",
+					g.pkg.Fset.Position(field.Pos), method, field.Name, other.Name, g.pkg.Fset.Position(other.Pos))
+			}
+			fields[method] = field
+		}
+	}
 
-		// can't find the type in the imports
-		if idx == -1 {
-			return ""
+	return nil
+}
+
+// checkConflicts checks that the methods generated for the fields and the struct don't conflict with
+// the fields and the methods of the struct, and that the types and functions generated in the package
+// don't conflict with the declarations of the package. Declarations in the output file are ignored,
+// since they are generated by the previous run. If skipExisting is set, methods declared by hand are skipped.
+func (g *generator) checkConflicts(st *Struct) error {
+	g.existing = make(map[string]struct{})
+
+	for _, field := range st.Fields {
+		if field.Tag == nil {
+			continue
 		}
 
-		// get the import statement for the package that the type is defined in
-		imp := g.imports[idx]
+		for _, method := range g.fieldMethods(field) {
+			obj, index, _ := types.LookupFieldOrMethod(types.NewPointer(st.Type), false, g.pkg.Types, method)
+			// Promoted fields and methods of embedded fields are shadowed by the generated methods.
+			if obj == nil || len(index) != 1 {
+				continue
+			}
+			position := g.pkg.Fset.Position(obj.Pos())
+			if position.Filename == g.writer.outputFile {
+				continue
+			}
 
-		if imp.Name == "." {
-			// return an empty string if the type is defined in the current package
-			return ""
+			switch obj.(type) {
+			case *types.Var:
+				return fmt.Errorf("%s: method %s of field %s conflicts with field %s at %s",
+					g.pkg.Fset.Position(field.Pos), method, field.Name, obj.Name(), position)
+			case *types.Func:
+				if g.skipExisting {
+					g.existing[method] = struct{}{}
+					continue
+				}
+				return fmt.Errorf("%s: method %s of field %s conflicts with method %s at %s; "+
+					"use -skip-existing to keep the existing method",
+					g.pkg.Fset.Position(field.Pos), method, field.Name, obj.Name(), position)
+			}
+		}
+	}
+
+	structPos := g.pkg.Fset.Position(g.pkg.Types.Scope().Lookup(st.Name).Pos())
+
+	for _, method := range g.typeMethods() {
+		obj, index, _ := types.LookupFieldOrMethod(types.NewPointer(st.Type), false, g.pkg.Types, method)
+		if obj == nil || len(index) != 1 {
+			continue
 		}
+		position := g.pkg.Fset.Position(obj.Pos())
+		if position.Filename == g.writer.outputFile {
+			continue
+		}
+		return fmt.Errorf("%s: method %s of struct %s conflicts with %s %s at %s",
+			structPos, method, st.Name, objectKind(obj), obj.Name(), position)
+	}
 
-		return imp.Name
+	for _, name := range g.packageDecls(st) {
+		obj := g.pkg.Types.Scope().Lookup(name)
+		if obj == nil {
+			continue
+		}
+		position := g.pkg.Fset.Position(obj.Pos())
+		if position.Filename == g.writer.outputFile {
+			continue
+		}
+		return fmt.Errorf("%s: %s generated for struct %s conflicts with %s %s at %s",
+			structPos, name, st.Name, objectKind(obj), obj.Name(), position)
+	}
+
+	return nil
+}
+
+// typeMethods returns the names of the methods generated for the whole struct.
+func (g *generator) typeMethods() []string {
+	var methods []string
+	if g.dirty != "" {
+		methods = append(methods, "DirtyFields", "IsDirty", "ResetDirty")
+	}
+	if g.registry {
+		methods = append(methods, "GetField", "SetField")
+	}
+	if g.json {
+		methods = append(methods, "MarshalJSON", "UnmarshalJSON")
+	}
+	if g.logValuer {
+		methods = append(methods, "LogValue", "String", "GoString")
+	}
+	if g.clone {
+		methods = append(methods, "Clone")
+	}
+	if g.equal {
+		methods = append(methods, "Equal")
+	}
+	if g.patch {
+		methods = append(methods, "ApplyPatch")
+	}
+	if g.view {
+		methods = append(methods, "View")
+	}
+	return methods
+}
+
+// packageDecls returns the names of the types and functions generated in the package for the struct.
+func (g *generator) packageDecls(st *Struct) []string {
+	var decls []string
+	if g.registry {
+		decls = append(decls, st.Name+"FieldNames")
+	}
+	if g.iface || g.fake {
+		reader, writer := g.interfaceNames(st.Name)
+		decls = append(decls, reader, writer)
+	}
+	if g.patch {
+		decls = append(decls, st.Name+"Patch")
+	}
+	if g.view {
+		decls = append(decls, st.Name+"View")
+	}
+	if g.builder {
+		decls = append(decls, st.Name+"Builder", "New"+st.Name+"Builder")
+	}
+	if g.options {
+		decls = append(decls, st.Name+"Option", "New"+st.Name)
+		for _, field := range st.Fields {
+			if field.Tag != nil && field.Tag.Option != nil {
+				decls = append(decls, g.methodNames(field).Option)
+			}
+		}
+	}
+	if g.constructor {
+		decls = append(decls, g.constructorFunc(st.Name))
+	}
+	return decls
+}
+
+// objectKind returns the kind of the declared object used in error messages.
+func objectKind(obj types.Object) string {
+	switch obj := obj.(type) {
+	case *types.Var:
+		if obj.IsField() {
+			return "field"
+		}
+		return "variable"
+	case *types.Func:
+		if obj.Type().(*types.Signature).Recv() != nil {
+			return "method"
+		}
+		return "function"
+	case *types.TypeName:
+		return "type"
+	case *types.Const:
+		return "constant"
+	}
+	return "declaration"
+}
+
+// isExisting reports whether the method is declared by hand and skipped.
+func (g *generator) isExisting(method string) bool {
+	_, ok := g.existing[method]
+	return ok
+}
+
+// methodName returns the name specified in the tag if any.
+// Otherwise, it returns the name built from format and the field name capitalized with initialisms,
+// e.g. "UserID" for "userId".
+func (g *generator) methodName(specified *string, format, fieldName string) string {
+	if specified != nil && *specified != "" {
+		return *specified
+	}
+	return fmt.Sprintf(format, g.initialisms.exportedName(fieldName))
+}
+
+func (g *generator) typeName(t types.Type) string {
+	return types.TypeString(t, g.qualifier)
+}
+
+// qualifier returns the name of the package used to qualify types in the generated file.
+func (g *generator) qualifier(p *types.Package) string {
+	// type is defined in the same package
+	if g.pkg.Types == p {
+		return "" // return an empty string
+	}
+
+	idx := slices.IndexFunc(g.imports, func(imp *Import) bool {
+		return imp.Path == p.Path()
 	})
+
+	// can't find the type in the imports, e.g. time.Time held by sql.NullTime.
+	// The package is imported by its path in the generated file.
+	if idx == -1 {
+		return p.Name()
+	}
+
+	// get the import statement for the package that the type is defined in
+	imp := g.imports[idx]
+
+	if imp.Name == "." {
+		// return an empty string if the type is defined in the current package
+		return ""
+	}
+
+	return imp.Name
+}
+
+// isZeroExpr returns an expression reporting whether x of type t is the zero value.
+func (g *generator) isZeroExpr(x string, t types.Type, typeString string) string {
+	if isNillable(t) {
+		return x + " == nil"
+	}
+	if _, ok := t.Underlying().(*types.Basic); ok {
+		return x + " == " + g.zeroValue(t, typeString)
+	}
+	// Composite literals must be parenthesized in if statements.
+	return fmt.Sprintf("%s == (%s{})", x, typeString)
+}
+
+// isNillable reports whether nil can be assigned to a value of type t.
+func isNillable(t types.Type) bool {
+	switch t := t.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map, *types.Chan, *types.Signature, *types.Interface:
+		return true
+	case *types.Basic:
+		return t.Kind() == types.UnsafePointer
+	}
+	return false
+}
+
+// initValue returns an expression allocating a value of type t to initialise a nil field,
+// or an empty string if the type can't be allocated in that way.
+func (g *generator) initValue(t types.Type, typeString string) string {
+	// Named types and aliases are initialised in the same way as their underlying types.
+	switch t := t.Underlying().(type) {
+	case *types.Pointer:
+		return fmt.Sprintf("new(%s)", g.typeName(t.Elem()))
+	case *types.Slice:
+		return fmt.Sprintf("make(%s, 0)", typeString)
+	case *types.Map:
+		return fmt.Sprintf("make(%s)", typeString)
+	}
+
+	return ""
+}
+
+// cloneValue returns statements replacing dst, which is a shallow copy of src, with a deep copy of src,
+// or an empty string if the shallow copy doesn't share memory with src.
+// Slices, maps and pointers are copied recursively, and types having Clone method are copied by it.
+func (g *generator) cloneValue(dst, src string, t types.Type, depth int) string {
+	if hasCloneMethod(t) {
+		if isNillable(t) {
+			return fmt.Sprintf("if %s != nil {\n%s = %s.Clone()\n}\n", src, dst, src)
+		}
+		return fmt.Sprintf("%s = %s.Clone()\n", dst, src)
+	}
+
+	switch t := t.(type) {
+	case *types.Pointer:
+		v := fmt.Sprintf("v%d", depth)
+		return fmt.Sprintf("if %s != nil {\n%s := *%s\n%s%s = &%s\n}\n",
+			src, v, src, g.cloneValue(v, v, t.Elem(), depth+1), dst, v)
+	case *types.Array:
+		i := fmt.Sprintf("i%d", depth)
+		elem := g.cloneValue(dst+"["+i+"]", src+"["+i+"]", t.Elem(), depth+1)
+		if elem == "" {
+			return ""
+		}
+		return fmt.Sprintf("for %s := range %s {\n%s}\n", i, src, elem)
+	case *types.Slice:
+		g.requirePackage("slices")
+		i := fmt.Sprintf("i%d", depth)
+		elem := g.cloneValue(dst+"["+i+"]", src+"["+i+"]", t.Elem(), depth+1)
+		if elem == "" {
+			return fmt.Sprintf("%s = slices.Clone(%s)\n", dst, src)
+		}
+		return fmt.Sprintf("if %s != nil {\n%s = slices.Clone(%s)\nfor %s := range %s {\n%s}\n}\n",
+			src, dst, src, i, src, elem)
+	case *types.Map:
+		g.requirePackage("maps")
+		k, v := fmt.Sprintf("k%d", depth), fmt.Sprintf("v%d", depth)
+		elem := g.cloneValue(v, v, t.Elem(), depth+1)
+		if elem == "" {
+			return fmt.Sprintf("%s = maps.Clone(%s)\n", dst, src)
+		}
+		return fmt.Sprintf("if %s != nil {\n%s = maps.Clone(%s)\nfor %s, %s := range %s {\n%s%s[%s] = %s\n}\n}\n",
+			src, dst, src, k, v, src, elem, dst, k, v)
+	case *types.Alias:
+		return g.cloneValue(dst, src, types.Unalias(t), depth)
+	case *types.Named:
+		// Fields of other structs may not be accessible, so they are copied only by their Clone methods.
+		if _, ok := t.Underlying().(*types.Struct); ok {
+			return ""
+		}
+
+		return g.cloneValue(dst, src, t.Underlying(), depth)
+	}
+
+	return ""
+}
+
+// equalExpr returns an expression reporting whether a and b of type t are equal,
+// or an empty string if values of the type can't be compared.
+func (g *generator) equalExpr(a, b string, t types.Type) string {
+	if hasEqualMethod(t) {
+		return fmt.Sprintf("%s.Equal(%s)", a, b)
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Slice:
+		if basic, ok := u.Elem().(*types.Basic); ok && basic.Kind() == types.Byte {
+			g.requirePackage("bytes")
+			return fmt.Sprintf("bytes.Equal(%s, %s)", a, b)
+		}
+		g.requirePackage("slices")
+		// Equal method is preferred, since == of values such as time.Time compares their internals.
+		if hasEqualMethod(u.Elem()) {
+			elem := g.typeName(u.Elem())
+			return fmt.Sprintf("slices.EqualFunc(%s, %s, func(x, y %s) bool { return x.Equal(y) })", a, b, elem)
+		}
+		if types.Comparable(u.Elem()) {
+			return fmt.Sprintf("slices.Equal(%s, %s)", a, b)
+		}
+	case *types.Map:
+		g.requirePackage("maps")
+		// Equal method is preferred, since == of values such as time.Time compares their internals.
+		if hasEqualMethod(u.Elem()) {
+			elem := g.typeName(u.Elem())
+			return fmt.Sprintf("maps.EqualFunc(%s, %s, func(x, y %s) bool { return x.Equal(y) })", a, b, elem)
+		}
+		if types.Comparable(u.Elem()) {
+			return fmt.Sprintf("maps.Equal(%s, %s)", a, b)
+		}
+	}
+
+	if types.Comparable(t) {
+		return fmt.Sprintf("%s == %s", a, b)
+	}
+
+	return ""
+}
+
+// hasEqualMethod reports whether t has Equal method comparing with a value of t, e.g. time.Time.
+func hasEqualMethod(t types.Type) bool {
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, "Equal")
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	return sig.Params().Len() == 1 && sig.Results().Len() == 1 && !sig.Variadic() &&
+		types.Identical(sig.Params().At(0).Type(), t) &&
+		types.Identical(sig.Results().At(0).Type(), types.Typ[types.Bool])
+}
+
+// hasCloneMethod reports whether t has Clone method returning a value of t.
+func hasCloneMethod(t types.Type) bool {
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, "Clone")
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	return sig.Params().Len() == 0 && sig.Results().Len() == 1 &&
+		types.Identical(sig.Results().At(0).Type(), t)
 }
 
 func (g *generator) zeroValue(t types.Type, typeString string) string {
@@ -304,6 +1650,8 @@ func (g *generator) zeroValue(t types.Type, typeString string) string {
 		}
 
 		return g.zeroValue(t.Underlying(), typeString)
+	case *types.Alias:
+		return g.zeroValue(types.Unalias(t), typeString)
 	}
 
 	return "nil"
@@ -0,0 +1,233 @@
+package accessor
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func TestGenerateErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		src     string // source of the package, in which ' is replaced with a backquote
+		options []Option
+		want    string // error message, in which %[1]s is replaced with the path of the source
+	}{
+		"NameCollision": {
+			src: `package test
+
+type Tester struct {
+	field1 string 'accessor:"getter:Name"'
+	field2 string 'accessor:"getter,setter:Name"'
+}
+`,
+			want: "%[1]s:5:2: method Name of field field2 collides with the method of field field1 at %[1]s:4:2",
+		},
+		"TypeMethodCollision": {
+			src: `package test
+
+type Tester struct {
+	field1 string 'accessor:"getter"'
+	string string 'accessor:"getter"'
+}
+`,
+			options: []Option{LogValuer(true)},
+			want:    "%[1]s:5:2: method String of field string collides with the method generated for struct Tester",
+		},
+		"TypeMethodConflict": {
+			src: `package test
+
+type Tester struct {
+	field1 string 'accessor:"getter"'
+}
+
+func (t *Tester) String() string { return t.field1 }
+`,
+			options: []Option{LogValuer(true)},
+			want:    "%[1]s:3:6: method String of struct Tester conflicts with method String at %[1]s:7:18",
+		},
+		"PackageDeclConflict": {
+			src: `package test
+
+type Tester struct {
+	field1 string 'accessor:"getter"'
+}
+
+type TesterBuilder struct{}
+`,
+			options: []Option{Builder(true)},
+			want:    "%[1]s:3:6: TesterBuilder generated for struct Tester conflicts with type TesterBuilder at %[1]s:7:6",
+		},
+		"DefaultNotAssignable": {
+			src: `package test
+
+type Tester struct {
+	field1 int 'accessor:"getter,default:\"text\""'
+}
+`,
+			want: "%[1]s:4:2: invalid default value for field field1: " +
+				`cannot use "text" (untyped string constant) as int value in assignment`,
+		},
+		"DefaultUndefined": {
+			src: `package test
+
+type Tester struct {
+	field1 int 'accessor:"getter,default:undefinedValue"'
+}
+`,
+			want: "%[1]s:4:2: invalid default value for field field1: undefined: undefinedValue",
+		},
+		"DefaultNotComparable": {
+			src: `package test
+
+type Tester struct {
+	field1 [1][]int 'accessor:"getter,default:[1][]int{}"'
+}
+`,
+			want: "%[1]s:4:2: default value can't be used for field field1: type [1][]int is not comparable",
+		},
+		"CASNotComparable": {
+			src: `package test
+
+type Tester struct {
+	field1 []int 'accessor:"getter,cas"'
+}
+`,
+			want: "%[1]s:4:2: cas can't be used for field field1: type []int is not comparable",
+		},
+		"UnwrapNotSQLNull": {
+			src: `package test
+
+type NullFoo struct {
+	Foo   string
+	Valid bool
+}
+
+type Tester struct {
+	field1 NullFoo 'accessor:"getter,unwrap"'
+}
+`,
+			want: "%[1]s:9:2: unwrap can't be used for field field1: type NullFoo is not a Null type of database/sql",
+		},
+		"OnChangeNotFound": {
+			src: `package test
+
+type Tester struct {
+	field1 string 'accessor:"setter,onChange:field1Changed"'
+}
+`,
+			want: "%[1]s:4:2: onChange method field1Changed for field field1 is not found in Tester",
+		},
+		"OnChangeFieldNotMethod": {
+			src: `package test
+
+type Tester struct {
+	field1 string 'accessor:"setter,onChange:field2"'
+	field2 func(old, new string)
+}
+`,
+			want: "%[1]s:4:2: onChange method field2 for field field1 is not found in Tester",
+		},
+		"OnChangeWrongSignature": {
+			src: `package test
+
+type Tester struct {
+	field1 string 'accessor:"setter,onChange:field1Changed"'
+}
+
+func (t *Tester) field1Changed(val string) {}
+`,
+			want: "%[1]s:4:2: onChange method field1Changed for field field1 must be func(old, new string), but func(val string)",
+		},
+		"ChangedOnlyNotComparable": {
+			src: `package test
+
+type Tester struct {
+	field1 []string 'accessor:"setter,onChange:field1Changed,changedOnly"'
+}
+
+func (t *Tester) field1Changed(old, new []string) {}
+`,
+			want: "%[1]s:4:2: changedOnly can't be used for field field1: type []string is not comparable",
+		},
+		"WithOnStructContainingLock": {
+			src: `package test
+
+import "sync"
+
+type Tester struct {
+	mu     sync.Mutex
+	field1 string 'accessor:"getter,with"'
+}
+`,
+			want: "%[1]s:7:2: cannot generate WithField1 for field field1: struct Tester contains a lock",
+		},
+		"WithOnStructEmbeddingLock": {
+			src: `package test
+
+import "sync"
+
+type Base struct {
+	mu sync.RWMutex
+}
+
+type Tester struct {
+	Base
+	field1 string 'accessor:"getter,with"'
+}
+`,
+			want: "%[1]s:11:2: cannot generate WithField1 for field field1: struct Tester contains a lock",
+		},
+		"WithOnStructHoldingArrayOfLocks": {
+			src: `package test
+
+import "sync"
+
+type Tester struct {
+	onces  [2]struct{ once sync.Once }
+	field1 string 'accessor:"getter,with"'
+}
+`,
+			want: "%[1]s:7:2: cannot generate WithField1 for field field1: struct Tester contains a lock",
+		},
+		"WithOnStructWithLockOption": {
+			src: `package test
+
+type locker interface {
+	Lock()
+	Unlock()
+}
+
+type Tester struct {
+	lock   locker
+	field1 string 'accessor:"with"'
+}
+`,
+			options: []Option{Lock("lock")},
+			want:    "%[1]s:10:2: cannot generate WithField1 for field field1: struct Tester contains a lock",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir, path := writeSource(t, strings.ReplaceAll(tt.src, "'", "`"))
+			src, err := Parse(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			options := append([]Option{Type("Tester")}, tt.options...)
+			err = Generate(afero.NewMemMapFs(), src, options...)
+			want := fmt.Sprintf(tt.want, path)
+			if err == nil || err.Error() != want {
+				t.Errorf("Generate() error = %v, want %s", err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package templates
+
+var Builder = `
+// {{.Struct}}Builder builds {{.Struct}} field by field.
+type {{.Struct}}Builder struct {
+  {{- range .Fields }}
+  {{.Field}} {{.Type}}
+  {{- end }}
+  {{- if .HasRequired }}
+  {{.BuilderSet}} map[string]bool
+  {{- end }}
+}
+
+// New{{.Struct}}Builder returns a new builder for {{.Struct}}.
+func New{{.Struct}}Builder() *{{.Struct}}Builder {
+  return &{{.Struct}}Builder{}
+}
+{{ range .Fields }}
+func (b *{{.Struct}}Builder) {{.BuilderMethod}}(val {{.Type}}) *{{.Struct}}Builder {
+  b.{{.Field}} = val
+  {{- if .Required }}
+  if b.{{$.BuilderSet}} == nil {
+    b.{{$.BuilderSet}} = make(map[string]bool)
+  }
+  b.{{$.BuilderSet}}["{{.Field}}"] = true
+  {{- end }}
+  return b
+}
+{{ end }}
+func (b *{{.Struct}}Builder) Build() (*{{.Struct}}, error) {
+  {{- if .HasRequired }}
+  var missing []string
+  {{- range .Fields }}
+  {{- if .Required }}
+  if !b.{{$.BuilderSet}}["{{.Field}}"] {
+    missing = append(missing, "{{.Field}}")
+  }
+  {{- end }}
+  {{- end }}
+  if len(missing) > 0 {
+    return nil, fmt.Errorf("{{.Struct}}: missing required fields: %s", strings.Join(missing, ", "))
+  }
+  {{- end }}
+  v := &{{.Struct}}{
+    {{- range .Fields }}
+    {{.Field}}: b.{{.Field}},
+    {{- end }}
+  }
+  {{- if .Validate }}
+  if err := v.Validate(); err != nil {
+    return nil, err
+  }
+  {{- end }}
+  return v, nil
+}
+`
@@ -0,0 +1,12 @@
+package templates
+
+var Clear = `
+func ({{.Receiver}} *{{.Struct}}) {{.ClearMethod}}() {
+  if {{.Receiver}} == nil {
+    return
+  }
+  {{- template "lock" . }}
+  {{.Receiver}}.{{.Field}} = {{.ZeroValue}}
+  {{- template "markDirty" . }}
+}
+`
@@ -0,0 +1,24 @@
+package templates
+
+var Clone = `
+// Clone returns a deep copy of {{.Struct}}.
+func ({{.Receiver}} *{{.Struct}}) Clone() *{{.Struct}} {
+  if {{.Receiver}} == nil {
+    return nil
+  }
+  {{- template "rlock" . }}
+
+  cloned := &{{.Struct}}{
+    {{- range .CloneFields }}
+    {{.Name}}: {{$.Receiver}}.{{.Name}},
+    {{- end }}
+  }
+  {{- range .CloneFields }}
+  {{- if .Copy }}
+  {{.Copy}}
+  {{- end }}
+  {{- end }}
+
+  return cloned
+}
+`
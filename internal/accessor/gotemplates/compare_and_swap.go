@@ -0,0 +1,16 @@
+package templates
+
+var CompareAndSwap = `
+func ({{.Receiver}} *{{.Struct}}) {{.CASMethod}}(oldVal, newVal {{.Type}}) bool {
+  if {{.Receiver}} == nil {
+    return false
+  }
+  {{- template "lock" . }}
+  if {{.Receiver}}.{{.Field}} != oldVal {
+    return false
+  }
+  {{.Receiver}}.{{.Field}} = newVal
+  {{- template "markDirty" . }}
+  return true
+}
+`
@@ -0,0 +1,26 @@
+package templates
+
+var Constructor = `
+// {{.Constructor}} returns a new {{.Struct}} with the required fields.
+func {{.Constructor}}(
+  {{- range $i, $f := .RequiredFields }}{{ if $i }}, {{ end }}{{$f.Param}} {{$f.Type}}{{ end -}}
+) {{ if .Validate }}(*{{.Struct}}, error){{ else }}*{{.Struct}}{{ end }} {
+  {{.Receiver}} := &{{.Struct}}{
+    {{- range .Fields }}
+    {{- if .Required }}
+    {{.Field}}: {{.Param}},
+    {{- else if .Default }}
+    {{.Field}}: {{.Default}},
+    {{- end }}
+    {{- end }}
+  }
+  {{- if .Validate }}
+  if err := {{.Receiver}}.Validate(); err != nil {
+    return nil, err
+  }
+  return {{.Receiver}}, nil
+  {{- else }}
+  return {{.Receiver}}
+  {{- end }}
+}
+`
@@ -0,0 +1,36 @@
+package templates
+
+var Dirty = `
+// DirtyFields returns names of the fields set since the last call of ResetDirty in sorted order.
+func ({{.Receiver}} *{{.Struct}}) DirtyFields() []string {
+  if {{.Receiver}} == nil {
+    return nil
+  }
+  {{- template "rlock" . }}
+  fields := make([]string, 0, len({{.Receiver}}.{{.Dirty}}))
+  for field := range {{.Receiver}}.{{.Dirty}} {
+    fields = append(fields, field)
+  }
+  slices.Sort(fields)
+  return fields
+}
+
+// IsDirty reports whether the field is set since the last call of ResetDirty.
+func ({{.Receiver}} *{{.Struct}}) IsDirty(field string) bool {
+  if {{.Receiver}} == nil {
+    return false
+  }
+  {{- template "rlock" . }}
+  _, ok := {{.Receiver}}.{{.Dirty}}[field]
+  return ok
+}
+
+// ResetDirty marks all fields as not dirty.
+func ({{.Receiver}} *{{.Struct}}) ResetDirty() {
+  if {{.Receiver}} == nil {
+    return
+  }
+  {{- template "lock" . }}
+  clear({{.Receiver}}.{{.Dirty}})
+}
+`
@@ -0,0 +1,37 @@
+package templates
+
+var Equal = `
+// Equal reports whether the tagged fields of {{.Struct}} are equal to those of other.
+func ({{.Receiver}} *{{.Struct}}) Equal(other *{{.Struct}}) bool {
+  if {{.Receiver}} == other {
+    return true
+  }
+  if {{.Receiver}} == nil || other == nil {
+    return false
+  }
+  {{- if ne .Lock "" }}
+
+  // Obtain the locks in the order of the addresses to avoid deadlock with other.Equal({{.Receiver}}).
+  first, second := {{.Receiver}}, other
+  if uintptr(unsafe.Pointer(other)) < uintptr(unsafe.Pointer({{.Receiver}})) {
+    first, second = other, {{.Receiver}}
+  }
+  {{- if eq .LockType "rwmutex" }}
+  first.{{.Lock}}.RLock()
+  defer first.{{.Lock}}.RUnlock()
+  second.{{.Lock}}.RLock()
+  defer second.{{.Lock}}.RUnlock()
+  {{- else }}
+  first.{{.Lock}}.Lock()
+  defer first.{{.Lock}}.Unlock()
+  second.{{.Lock}}.Lock()
+  defer second.{{.Lock}}.Unlock()
+  {{- end }}
+  {{- end }}
+
+  return {{ if .Equals }}
+  {{- range $i, $e := .Equals }}{{ if $i }} &&
+    {{ end }}{{ $e }}{{ end }}
+  {{- else }}true{{ end }}
+}
+`
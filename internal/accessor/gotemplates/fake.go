@@ -0,0 +1,49 @@
+package templates
+
+var Fake = `
+// Fake{{.Reader}} is an in-memory fake of {{.Reader}}.
+// Results of the methods are set to <Method>Returns and calls are recorded to <Method>Calls.
+type Fake{{.Reader}} struct {
+  mu sync.Mutex
+  {{- range .Readers }}
+
+  {{.Name}}Returns {{.ReturnsType}}
+  {{.Name}}Calls []{{.CallType}}
+  {{- end }}
+}
+{{ range .Readers }}
+// {{.Name}} records the call and returns {{.Name}}Returns.
+func (f *Fake{{$.Reader}}) {{.Name}}({{.ParamList}}) {{.ResultList}} {
+  f.mu.Lock()
+  defer f.mu.Unlock()
+  f.{{.Name}}Calls = append(f.{{.Name}}Calls, {{.CallType}}{{.CallValue}})
+  return {{.ReturnValues (printf "f.%sReturns" .Name)}}
+}
+{{ end }}
+// Fake{{.Writer}} is an in-memory fake of {{.Writer}}.
+// Results of the methods are set to <Method>Returns and calls are recorded to <Method>Calls.
+type Fake{{.Writer}} struct {
+  mu sync.Mutex
+  {{- range .Writers }}
+{{ if .Results }}
+  {{.Name}}Returns {{.ReturnsType}}
+  {{- end }}
+  {{.Name}}Calls []{{.CallType}}
+  {{- end }}
+}
+{{ range .Writers }}
+// {{.Name}} records the call{{if .Results}} and returns {{.Name}}Returns{{end}}.
+func (f *Fake{{$.Writer}}) {{.Name}}({{.ParamList}}) {{.ResultList}} {
+  f.mu.Lock()
+  defer f.mu.Unlock()
+  f.{{.Name}}Calls = append(f.{{.Name}}Calls, {{.CallType}}{{.CallValue}})
+  {{- if .Results }}
+  return {{.ReturnValues (printf "f.%sReturns" .Name)}}
+  {{- end }}
+}
+{{ end }}
+var (
+  _ {{.Reader}} = (*Fake{{.Reader}})(nil)
+  _ {{.Writer}} = (*Fake{{.Writer}})(nil)
+)
+`
@@ -3,7 +3,11 @@ package templates
 var Getter = `
 func ({{.Receiver}} *{{.Struct}}) {{.GetterMethod}}() {{.Type}} {
   if {{.Receiver}} == nil {
+    {{- if .Default }}
+    return {{.Default}}
+    {{- else }}
     return {{.ZeroValue}}
+    {{- end }}
   }
   {{- if ne .Lock "" }}
   {{- if eq .LockType "rwmutex" }}
@@ -14,5 +18,10 @@ func ({{.Receiver}} *{{.Struct}}) {{.GetterMethod}}() {{.Type}} {
   defer {{.Receiver}}.{{.Lock}}.Unlock()
   {{- end }}
   {{- end }}
+  {{- if .Default }}
+  if {{.IsZero}} {
+    return {{.Default}}
+  }
+  {{- end }}
   return {{.Receiver}}.{{.Field}}
 }`
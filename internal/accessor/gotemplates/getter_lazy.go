@@ -0,0 +1,24 @@
+package templates
+
+var GetterLazy = `
+func ({{.Receiver}} *{{.Struct}}) {{.GetterMethod}}() {{.Type}} {
+  if {{.Receiver}} == nil {
+    return {{.ZeroValue}}
+  }
+  {{- if and (ne .Lock "") (eq .LockType "rwmutex") }}
+  {{.Receiver}}.{{.Lock}}.RLock()
+  if {{.Receiver}}.{{.Field}} != nil {
+    defer {{.Receiver}}.{{.Lock}}.RUnlock()
+    return {{.Receiver}}.{{.Field}}
+  }
+  {{.Receiver}}.{{.Lock}}.RUnlock()
+  {{- end }}
+  {{- if ne .Lock "" }}
+  {{.Receiver}}.{{.Lock}}.Lock()
+  defer {{.Receiver}}.{{.Lock}}.Unlock()
+  {{- end }}
+  if {{.Receiver}}.{{.Field}} == nil {
+    {{.Receiver}}.{{.Field}} = {{.InitValue}}
+  }
+  return {{.Receiver}}.{{.Field}}
+}`
@@ -11,5 +11,10 @@ func ({{.Receiver}} {{.Struct}}) {{.GetterMethod}}() {{.Type}} {
 	defer {{.Receiver}}.{{.Lock}}.Unlock()
   {{- end }}
   {{- end }}
+  {{- if .Default }}
+  if {{.IsZero}} {
+    return {{.Default}}
+  }
+  {{- end }}
   return {{.Receiver}}.{{.Field}}
 }`
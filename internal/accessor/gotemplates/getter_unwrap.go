@@ -0,0 +1,10 @@
+package templates
+
+var GetterUnwrap = `
+func ({{.Receiver}} *{{.Struct}}) {{.GetterMethod}}() ({{.ValueType}}, bool) {
+  if {{.Receiver}} == nil {
+    return {{.ValueZero}}, false
+  }
+  {{- template "rlock" . }}
+  return {{.Value}}, {{.IsSet}}
+}`
@@ -0,0 +1,11 @@
+package templates
+
+var Has = `
+func ({{.Receiver}} *{{.Struct}}) {{.HasMethod}}() bool {
+  if {{.Receiver}} == nil {
+    return false
+  }
+  {{- template "rlock" . }}
+  return {{.IsSet}}
+}
+`
@@ -0,0 +1,22 @@
+package templates
+
+var Interface = `
+// {{.Reader}} is the interface of accessor methods reading fields of {{.Struct}}.
+type {{.Reader}} interface {
+  {{- range .Readers }}
+  {{.Name}}({{.ParamList}}) {{.ResultList}}
+  {{- end }}
+}
+
+// {{.Writer}} is the interface of accessor methods writing fields of {{.Struct}}.
+type {{.Writer}} interface {
+  {{- range .Writers }}
+  {{.Name}}({{.ParamList}}) {{.ResultList}}
+  {{- end }}
+}
+
+var (
+  _ {{.Reader}} = (*{{.Struct}})(nil)
+  _ {{.Writer}} = (*{{.Struct}})(nil)
+)
+`
@@ -0,0 +1,68 @@
+package templates
+
+var JSON = `
+// MarshalJSON encodes the fields which have getters into JSON through the getters.
+func ({{.Receiver}} *{{.Struct}}) MarshalJSON() ([]byte, error) {
+  var values struct {
+    {{- range .JSONGetters }}
+    {{- if .Unwrap }}
+    {{.BuilderMethod}} *{{.ValueType}} {{.JSONTag}}
+    {{- else }}
+    {{.BuilderMethod}} {{.Type}} {{.JSONTag}}
+    {{- end }}
+    {{- end }}
+  }
+  {{- range .JSONGetters }}
+  {{- if .Unwrap }}
+  if val, ok := {{.Receiver}}.{{.GetterMethod}}(); ok {
+    values.{{.BuilderMethod}} = &val
+  }
+  {{- else }}
+  values.{{.BuilderMethod}} = {{.Receiver}}.{{.GetterMethod}}()
+  {{- end }}
+  {{- end }}
+  return json.Marshal(values)
+}
+
+// UnmarshalJSON decodes JSON into the fields which have setters through the setters.
+// Fields which don't appear in JSON are left unchanged.
+{{- if .Validate }}
+// The decoded values are validated before they are set, and no field is changed if the validation fails.
+{{- end }}
+func ({{.Receiver}} *{{.Struct}}) UnmarshalJSON(data []byte) error {
+  var values struct {
+    {{- range .JSONSetters }}
+    {{.BuilderMethod}} *{{.SetterType}} {{.JSONTag}}
+    {{- end }}
+  }
+  if err := json.Unmarshal(data, &values); err != nil {
+    return err
+  }
+  {{- if .Validate }}
+
+  // Validate a copy holding the decoded values first, so that no field is changed if the validation fails.
+  {{- template "rlockNoDefer" . }}
+  tmp := &{{.Struct}}{
+    {{- range .Copies }}
+    {{.Name}}: {{$.Receiver}}.{{.Name}},
+    {{- end }}
+  }
+  {{- template "runlock" . }}
+  {{- range .JSONSetters }}
+  if values.{{.BuilderMethod}} != nil {
+    val := *values.{{.BuilderMethod}}
+    tmp.{{.Field}} = {{.SetValue}}
+  }
+  {{- end }}
+  if err := tmp.Validate(); err != nil {
+    return err
+  }
+  {{- end }}
+  {{- range .JSONSetters }}
+  if values.{{.BuilderMethod}} != nil {
+    {{.Receiver}}.{{.SetterMethod}}(*values.{{.BuilderMethod}})
+  }
+  {{- end }}
+  return nil
+}
+`
@@ -0,0 +1,42 @@
+package templates
+
+// Lock defines templates obtaining and releasing the lock, which can be used in other templates.
+var Lock = `
+{{- define "lock" }}
+  {{- if ne .Lock "" }}
+  {{.Receiver}}.{{.Lock}}.Lock()
+  defer {{.Receiver}}.{{.Lock}}.Unlock()
+  {{- end }}
+{{- end }}
+
+{{- define "rlock" }}
+  {{- if ne .Lock "" }}
+  {{- if eq .LockType "rwmutex" }}
+  {{.Receiver}}.{{.Lock}}.RLock()
+  defer {{.Receiver}}.{{.Lock}}.RUnlock()
+  {{- else }}
+  {{.Receiver}}.{{.Lock}}.Lock()
+  defer {{.Receiver}}.{{.Lock}}.Unlock()
+  {{- end }}
+  {{- end }}
+{{- end }}
+
+{{- define "rlockNoDefer" }}
+  {{- if ne .Lock "" }}
+  {{- if eq .LockType "rwmutex" }}
+  {{.Receiver}}.{{.Lock}}.RLock()
+  {{- else }}
+  {{.Receiver}}.{{.Lock}}.Lock()
+  {{- end }}
+  {{- end }}
+{{- end }}
+
+{{- define "runlock" }}
+  {{- if ne .Lock "" }}
+  {{- if eq .LockType "rwmutex" }}
+  {{.Receiver}}.{{.Lock}}.RUnlock()
+  {{- else }}
+  {{.Receiver}}.{{.Lock}}.Unlock()
+  {{- end }}
+  {{- end }}
+{{- end }}`
@@ -0,0 +1,48 @@
+package templates
+
+var LogValue = `
+// LogValue implements slog.LogValuer with the tagged fields, redacting secret fields.
+func ({{.Receiver}} *{{.Struct}}) LogValue() slog.Value {
+  if {{.Receiver}} == nil {
+    return slog.AnyValue(nil)
+  }
+  {{- template "rlock" . }}
+  return slog.GroupValue(
+    {{- range .Fields }}
+    {{- if .Secret }}
+    slog.String("{{.Field}}", "[REDACTED]"),
+    {{- else if .LogValuer }}
+    slog.Attr{Key: "{{.Field}}", Value: {{.Receiver}}.{{.Field}}.LogValue()},
+    {{- else }}
+    slog.Any("{{.Field}}", {{.Receiver}}.{{.Field}}),
+    {{- end }}
+    {{- end }}
+  )
+}
+
+// String returns the tagged fields formatted as a string, redacting secret fields.
+func ({{.Receiver}} *{{.Struct}}) String() string {
+  if {{.Receiver}} == nil {
+    return "<nil>"
+  }
+  {{- template "rlock" . }}
+  return fmt.Sprintf("{{.Struct}}{
+    {{- range $i, $f := .Fields }}{{ if $i }} {{ end }}{{$f.Field}}:{{ if $f.Secret }}[REDACTED]{{ else }}%v{{ end }}{{ end -}}
+  }"
+  {{- range .Fields }}{{ if not .Secret }}, {{.Receiver}}.{{.Field}}{{ end }}{{ end -}}
+  )
+}
+
+// GoString returns the tagged fields formatted as Go syntax, redacting secret fields.
+func ({{.Receiver}} *{{.Struct}}) GoString() string {
+  if {{.Receiver}} == nil {
+    return "(*{{.Package}}.{{.Struct}})(nil)"
+  }
+  {{- template "rlock" . }}
+  return fmt.Sprintf("&{{.Package}}.{{.Struct}}{
+    {{- range $i, $f := .Fields }}{{ if $i }}, {{ end }}{{$f.Field}}:{{ if $f.Secret }}\"[REDACTED]\"{{ else }}%#v{{ end }}{{ end -}}
+  }"
+  {{- range .Fields }}{{ if not .Secret }}, {{.Receiver}}.{{.Field}}{{ end }}{{ end -}}
+  )
+}
+`
@@ -0,0 +1,12 @@
+package templates
+
+// MarkDirty defines a template marking the field as dirty, which can be used in other templates.
+var MarkDirty = `
+{{- define "markDirty" }}
+  {{- if ne .Dirty "" }}
+  if {{.Receiver}}.{{.Dirty}} == nil {
+    {{.Receiver}}.{{.Dirty}} = make(map[string]struct{})
+  }
+  {{.Receiver}}.{{.Dirty}}["{{.Field}}"] = struct{}{}
+  {{- end }}
+{{- end }}`
@@ -0,0 +1,29 @@
+package templates
+
+var Options = `
+// {{.Struct}}Option configures {{.Struct}} created by New{{.Struct}}.
+type {{.Struct}}Option func(*{{.Struct}})
+{{ range .Fields }}
+{{- if .Option }}
+func {{.OptionFunc}}(val {{.Type}}) {{.Struct}}Option {
+  return func({{.Receiver}} *{{.Struct}}) {
+    {{.Receiver}}.{{.Field}} = val
+  }
+}
+{{ end }}
+{{- end }}
+// New{{.Struct}} returns a new {{.Struct}} configured by opts.
+func New{{.Struct}}(opts ...{{.Struct}}Option) *{{.Struct}} {
+  {{.Receiver}} := &{{.Struct}}{
+    {{- range .Fields }}
+    {{- if .Default }}
+    {{.Field}}: {{.Default}},
+    {{- end }}
+    {{- end }}
+  }
+  for _, opt := range opts {
+    opt({{.Receiver}})
+  }
+  return {{.Receiver}}
+}
+`
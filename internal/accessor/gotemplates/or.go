@@ -0,0 +1,14 @@
+package templates
+
+var Or = `
+func ({{.Receiver}} *{{.Struct}}) {{.OrMethod}}(def {{.ValueType}}) {{.ValueType}} {
+  if {{.Receiver}} == nil {
+    return def
+  }
+  {{- template "rlock" . }}
+  if {{.IsUnset}} {
+    return def
+  }
+  return {{.Value}}
+}
+`
@@ -0,0 +1,14 @@
+package templates
+
+var OrZero = `
+func ({{.Receiver}} *{{.Struct}}) {{.OrZeroMethod}}() {{.ValueType}} {
+  if {{.Receiver}} == nil {
+    return {{.ValueZero}}
+  }
+  {{- template "rlock" . }}
+  if {{.IsUnset}} {
+    return {{.ValueZero}}
+  }
+  return {{.Value}}
+}
+`
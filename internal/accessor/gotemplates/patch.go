@@ -0,0 +1,81 @@
+package templates
+
+var Patch = `
+// {{.Struct}}Patch contains new values of the fields of {{.Struct}} which have setters.
+// Nil fields are left unchanged by ApplyPatch.
+type {{.Struct}}Patch struct {
+  {{- range .Setters }}
+  {{.BuilderMethod}} *{{.SetterType}} {{.JSONTag}}
+  {{- end }}
+}
+
+// ApplyPatch sets the non-nil values of the patch to the fields in the same way as the setters,
+// holding the lock once for the whole patch.
+// onChange methods are called after the lock is released.
+{{- if .Validate }}
+// The values are validated before they are set, and no field is changed if the validation fails.
+{{- end }}
+func ({{.Receiver}} *{{.Struct}}) ApplyPatch(patch {{.Struct}}Patch) error {
+  if {{.Receiver}} == nil {
+    return nil
+  }
+  {{- if .HasOnChange }}
+  var hooks []func()
+  {{- end }}
+  {{- if ne .Lock "" }}
+  {{.Receiver}}.{{.Lock}}.Lock()
+  {{- end }}
+  {{- if .Validate }}
+
+  // Validate a copy holding the values of the patch first, so that no field is changed if the validation fails.
+  tmp := &{{.Struct}}{
+    {{- range .Copies }}
+    {{.Name}}: {{$.Receiver}}.{{.Name}},
+    {{- end }}
+  }
+  {{- range .Setters }}
+  if patch.{{.BuilderMethod}} != nil {
+    val := *patch.{{.BuilderMethod}}
+    tmp.{{.Field}} = {{.SetValue}}
+  }
+  {{- end }}
+  if err := tmp.Validate(); err != nil {
+    {{- if ne .Lock "" }}
+    {{.Receiver}}.{{.Lock}}.Unlock()
+    {{- end }}
+    return err
+  }
+  {{- end }}
+  {{- range .Setters }}
+  if patch.{{.BuilderMethod}} != nil {
+    val := *patch.{{.BuilderMethod}}
+    {{- if .OnChange }}
+    oldVal, newVal := {{.Receiver}}.{{.Field}}, {{.SetValue}}
+    {{.Receiver}}.{{.Field}} = newVal
+    {{- template "markDirty" . }}
+    {{- if .ChangedOnly }}
+    if oldVal != newVal {
+      hooks = append(hooks, func() { {{.Receiver}}.{{.OnChange}}(oldVal, newVal) })
+    }
+    {{- else }}
+    hooks = append(hooks, func() { {{.Receiver}}.{{.OnChange}}(oldVal, newVal) })
+    {{- end }}
+    {{- else }}
+    {{.Receiver}}.{{.Field}} = {{.SetValue}}
+    {{- template "markDirty" . }}
+    {{- end }}
+  }
+  {{- end }}
+  {{- if ne .Lock "" }}
+  {{.Receiver}}.{{.Lock}}.Unlock()
+  {{- end }}
+  {{- if .HasOnChange }}
+
+  for _, hook := range hooks {
+    hook()
+  }
+  {{- end }}
+
+  return nil
+}
+`
@@ -0,0 +1,55 @@
+package templates
+
+var Registry = `
+// {{.Struct}}FieldNames returns names of the tagged fields of {{.Struct}} in declaration order.
+func {{.Struct}}FieldNames() []string {
+  return []string{
+    {{- range .Fields }}
+    "{{.Field}}",
+    {{- end }}
+  }
+}
+
+// GetField returns the value of the field through its getter.
+// It reports false if the field is not found or has no getter.
+func ({{.Receiver}} *{{.Struct}}) GetField(name string) (any, bool) {
+  switch name {
+  {{- range .Getters }}
+  case "{{.Field}}":
+    {{- if .Unwrap }}
+    if val, ok := {{.Receiver}}.{{.GetterMethod}}(); ok {
+      return val, true
+    }
+    return nil, true
+    {{- else }}
+    return {{.Receiver}}.{{.GetterMethod}}(), true
+    {{- end }}
+  {{- end }}
+  }
+  return nil, false
+}
+
+// SetField sets the value to the field through its setter.
+// It returns an error if the field is not found, has no setter or the type of the value doesn't match.
+// A nil value sets the zero value to the field whose type can be nil.
+func ({{.Receiver}} *{{.Struct}}) SetField(name string, value any) error {
+  switch name {
+  {{- range .Setters }}
+  case "{{.Field}}":
+    {{- if .SetterNil }}
+    if value == nil {
+      {{.Receiver}}.{{.SetterMethod}}(nil)
+      return nil
+    }
+    {{- end }}
+    val, ok := value.({{.SetterType}})
+    if !ok {
+      return fmt.Errorf("{{.Struct}}: field {{.Field}} requires {{.SetterType}}, but got %T", value)
+    }
+    {{.Receiver}}.{{.SetterMethod}}(val)
+    return nil
+  {{- end }}
+  }
+  return fmt.Errorf("{{.Struct}}: field %s is not found or has no setter", name)
+}
+`
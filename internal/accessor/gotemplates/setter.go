@@ -1,14 +1,34 @@
 package templates
 
 var Setter = `
-func ({{.Receiver}} *{{.Struct}}) {{.SetterMethod}}(val {{.Type}}) {
+func ({{.Receiver}} *{{.Struct}}) {{.SetterMethod}}(val {{.SetterType}}) {
   if {{.Receiver}} == nil {
     return
   }
+  {{- if .OnChange }}
+  {{- if ne .Lock "" }}
+  {{.Receiver}}.{{.Lock}}.Lock()
+  {{- end }}
+  oldVal, newVal := {{.Receiver}}.{{.Field}}, {{.SetValue}}
+  {{.Receiver}}.{{.Field}} = newVal
+  {{- template "markDirty" . }}
+  {{- if ne .Lock "" }}
+  {{.Receiver}}.{{.Lock}}.Unlock()
+  {{- end }}
+  {{- if .ChangedOnly }}
+  if oldVal != newVal {
+    {{.Receiver}}.{{.OnChange}}(oldVal, newVal)
+  }
+  {{- else }}
+  {{.Receiver}}.{{.OnChange}}(oldVal, newVal)
+  {{- end }}
+  {{- else }}
   {{- if ne .Lock "" }}
   {{.Receiver}}.{{.Lock}}.Lock()
   defer {{.Receiver}}.{{.Lock}}.Unlock()
   {{- end }}
-  {{.Receiver}}.{{.Field}} = val
+  {{.Receiver}}.{{.Field}} = {{.SetValue}}
+  {{- template "markDirty" . }}
+  {{- end }}
 }
 `
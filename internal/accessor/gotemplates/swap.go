@@ -0,0 +1,13 @@
+package templates
+
+var Swap = `
+func ({{.Receiver}} *{{.Struct}}) {{.SwapMethod}}(newVal {{.Type}}) (oldVal {{.Type}}) {
+  if {{.Receiver}} == nil {
+    return {{.ZeroValue}}
+  }
+  {{- template "lock" . }}
+  oldVal, {{.Receiver}}.{{.Field}} = {{.Receiver}}.{{.Field}}, newVal
+  {{- template "markDirty" . }}
+  return oldVal
+}
+`
@@ -0,0 +1,18 @@
+package templates
+
+var View = `
+// {{.Struct}}View is a read-only view of {{.Struct}} exposing only the methods reading fields.
+type {{.Struct}}View struct {
+  t *{{.Struct}}
+}
+
+// View returns a read-only view of {{.Struct}}.
+func ({{.Receiver}} *{{.Struct}}) View() {{.Struct}}View {
+  return {{.Struct}}View{t: {{.Receiver}}}
+}
+{{ range .Readers }}
+// {{.Name}} calls {{.Name}} of the viewed {{$.Struct}}.
+func (v {{$.Struct}}View) {{.Name}}({{.ParamList}}) {{.ResultList}} {
+  return v.t.{{.Name}}({{.ArgList}})
+}
+{{ end }}`
@@ -0,0 +1,8 @@
+package templates
+
+var With = `
+func ({{.Receiver}} {{.Struct}}) {{.WithMethod}}(val {{.Type}}) {{.Struct}} {
+  {{.Receiver}}.{{.Field}} = val
+  return {{.Receiver}}
+}
+`
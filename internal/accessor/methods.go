@@ -0,0 +1,170 @@
+package accessor
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+// methodSignature describes an accessor method generated for a field.
+type methodSignature struct {
+	Name    string
+	Params  []*methodParam
+	Results []string
+
+	packages []string // paths of the packages used by the parameters and results
+}
+
+// methodParam describes a parameter of an accessor method.
+type methodParam struct {
+	Name  string
+	Type  string
+	Field string // exported name of the parameter used in a struct recording calls
+}
+
+// ParamList returns the parameters joined for the method declaration, e.g. "oldVal int, newVal int".
+func (m *methodSignature) ParamList() string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		params[i] = p.Name + " " + p.Type
+	}
+	return strings.Join(params, ", ")
+}
+
+// ArgList returns the parameter names joined for calling the method, e.g. "oldVal, newVal".
+func (m *methodSignature) ArgList() string {
+	args := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		args[i] = p.Name
+	}
+	return strings.Join(args, ", ")
+}
+
+// ResultList returns the results for the method declaration, e.g. "(string, bool)".
+func (m *methodSignature) ResultList() string {
+	if len(m.Results) <= 1 {
+		return strings.Join(m.Results, "")
+	}
+	return fmt.Sprintf("(%s)", strings.Join(m.Results, ", "))
+}
+
+// CallType returns the type recording a call of the method, e.g. "struct{ OldVal int; NewVal int }".
+func (m *methodSignature) CallType() string {
+	fields := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		fields[i] = p.Field + " " + p.Type
+	}
+	return fmt.Sprintf("struct{ %s }", strings.Join(fields, "; "))
+}
+
+// CallValue returns the value recording a call of the method, e.g. "{OldVal: oldVal, NewVal: newVal}".
+func (m *methodSignature) CallValue() string {
+	fields := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		fields[i] = p.Field + ": " + p.Name
+	}
+	return fmt.Sprintf("{%s}", strings.Join(fields, ", "))
+}
+
+// ReturnsType returns the type holding the results of the method, e.g. "struct{ R0 string; R1 bool }".
+func (m *methodSignature) ReturnsType() string {
+	fields := make([]string, len(m.Results))
+	for i, r := range m.Results {
+		fields[i] = fmt.Sprintf("R%d %s", i, r)
+	}
+	return fmt.Sprintf("struct{ %s }", strings.Join(fields, "; "))
+}
+
+// ReturnValues returns the results of the method read from v, e.g. "v.R0, v.R1".
+func (m *methodSignature) ReturnValues(v string) string {
+	values := make([]string, len(m.Results))
+	for i := range m.Results {
+		values[i] = fmt.Sprintf("%s.R%d", v, i)
+	}
+	return strings.Join(values, ", ")
+}
+
+// methodParam returns the parameter of an accessor method, whose exported name is built with initialisms.
+func (g *generator) methodParam(name, typ string) *methodParam {
+	return &methodParam{Name: name, Type: typ, Field: g.initialisms.exportedName(name)}
+}
+
+// accessorMethods returns signatures of the accessor methods generated for the field,
+// which are divided into methods reading the field and methods writing the field.
+// Methods are ordered in the same way as they are generated.
+func (g *generator) accessorMethods(field *Field, params *methodGenParameters) (readers, writers []*methodSignature) {
+	tag := field.Tag
+
+	typePackages := g.getUsedPackages(field.Type)
+	var valuePackages []string
+	if ptr, ok := field.Type.Underlying().(*types.Pointer); ok {
+		valuePackages = g.getUsedPackages(ptr.Elem())
+	} else if nullValue := nullValueField(field.Type); nullValue != nil {
+		valuePackages = g.getUsedPackages(nullValue.Type())
+	}
+	setterPackages := typePackages
+	if tag.Unwrap {
+		setterPackages = valuePackages
+	}
+
+	if tag.Getter != nil {
+		results := []string{params.Type}
+		if tag.Unwrap {
+			results = []string{params.ValueType, "bool"}
+		}
+		packages := typePackages
+		if tag.Unwrap {
+			packages = valuePackages
+		}
+		readers = append(readers, &methodSignature{Name: params.GetterMethod, Results: results, packages: packages})
+	}
+	if tag.Setter != nil {
+		writers = append(writers, &methodSignature{
+			Name:     params.SetterMethod,
+			Params:   []*methodParam{g.methodParam("val", params.SetterType)},
+			packages: setterPackages,
+		})
+	}
+	if tag.Has != nil {
+		readers = append(readers, &methodSignature{Name: params.HasMethod, Results: []string{"bool"}})
+	}
+	if tag.Clear != nil {
+		writers = append(writers, &methodSignature{Name: params.ClearMethod})
+	}
+	if tag.OrZero != nil {
+		readers = append(readers, &methodSignature{
+			Name:     params.OrZeroMethod,
+			Results:  []string{params.ValueType},
+			packages: valuePackages,
+		})
+	}
+	if tag.Or != nil {
+		readers = append(readers, &methodSignature{
+			Name:     params.OrMethod,
+			Params:   []*methodParam{g.methodParam("def", params.ValueType)},
+			Results:  []string{params.ValueType},
+			packages: valuePackages,
+		})
+	}
+	if tag.Swap != nil {
+		writers = append(writers, &methodSignature{
+			Name:     params.SwapMethod,
+			Params:   []*methodParam{g.methodParam("newVal", params.Type)},
+			Results:  []string{params.Type},
+			packages: typePackages,
+		})
+	}
+	if tag.CAS != nil {
+		writers = append(writers, &methodSignature{
+			Name: params.CASMethod,
+			Params: []*methodParam{
+				g.methodParam("oldVal", params.Type),
+				g.methodParam("newVal", params.Type),
+			},
+			Results:  []string{"bool"},
+			packages: typePackages,
+		})
+	}
+
+	return readers, writers
+}
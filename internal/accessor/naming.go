@@ -0,0 +1,122 @@
+package accessor
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// commonInitialisms are the initialisms written in upper case in Go names, following golint.
+var commonInitialisms = []string{
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP", "HTTPS", "ID",
+	"IP", "JSON", "LHS", "QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH", "TCP",
+	"TLS", "TTL", "UDP", "UI", "UID", "UUID", "URI", "URL", "UTF8", "VM", "XML", "XMPP",
+	"XSRF", "XSS",
+}
+
+// initialisms is a set of initialisms in upper case.
+type initialisms map[string]struct{}
+
+// newInitialisms returns the common initialisms with the additional ones.
+func newInitialisms(additional ...string) initialisms {
+	set := make(initialisms, len(commonInitialisms)+len(additional))
+	for _, initialism := range commonInitialisms {
+		set[initialism] = struct{}{}
+	}
+	for _, initialism := range additional {
+		set[strings.ToUpper(initialism)] = struct{}{}
+	}
+	return set
+}
+
+func (in initialisms) contains(word string) bool {
+	_, ok := in[strings.ToUpper(word)]
+	return ok
+}
+
+// exportedName returns the name capitalized with the initialisms in upper case,
+// e.g. "HTTPClient" for "httpClient" and "UserID" for "userId".
+func (in initialisms) exportedName(name string) string {
+	var b strings.Builder
+	for _, word := range in.splitWords(name) {
+		if in.contains(word) {
+			b.WriteString(strings.ToUpper(word))
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(word)
+		b.WriteRune(unicode.ToUpper(r))
+		b.WriteString(word[size:])
+	}
+	return b.String()
+}
+
+// snakeName returns the name in snake case, e.g. "json_api_server" for "JSONAPIServer".
+func (in initialisms) snakeName(name string) string {
+	var words []string
+	for _, word := range in.splitWords(name) {
+		if word != "_" {
+			words = append(words, strings.ToLower(word))
+		}
+	}
+	return strings.Join(words, "_")
+}
+
+// splitWords splits the name into words at changes of case and underscores, which are kept as words.
+// Runs of upper case letters are split further into the initialisms, e.g. "JSON" and "API" of "JSONAPI".
+func (in initialisms) splitWords(name string) []string {
+	runes := []rune(name)
+
+	var words []string
+	start := 0
+	for i := 1; i <= len(runes); i++ {
+		if i < len(runes) && !isWordBoundary(runes, i) {
+			continue
+		}
+		word := string(runes[start:i])
+		if len(runes[start:i]) > 1 && strings.ToUpper(word) == word {
+			words = append(words, in.splitInitialisms(word)...)
+		} else {
+			words = append(words, word)
+		}
+		start = i
+	}
+
+	return words
+}
+
+// splitInitialisms splits the upper case word into the longest initialisms from the beginning.
+// The rest of the word which doesn't start with an initialism is returned as a word.
+func (in initialisms) splitInitialisms(word string) []string {
+	var words []string
+	for word != "" {
+		longest := ""
+		for i := len(word); i > 0; i-- {
+			if in.contains(word[:i]) {
+				longest = word[:i]
+				break
+			}
+		}
+		if longest == "" {
+			return append(words, word)
+		}
+		words = append(words, longest)
+		word = word[len(longest):]
+	}
+	return words
+}
+
+// isWordBoundary reports whether a new word starts at runes[i].
+// Digits belong to the preceding word, e.g. "UTF8".
+func isWordBoundary(runes []rune, i int) bool {
+	prev, curr := runes[i-1], runes[i]
+	switch {
+	case prev == '_' || curr == '_':
+		return true
+	case unicode.IsUpper(curr) && (unicode.IsLower(prev) || unicode.IsDigit(prev)):
+		return true
+	case unicode.IsUpper(prev) && unicode.IsUpper(curr) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+		// The last upper case letter before lower case letters starts a word, e.g. "Server" of "HTTPServer".
+		return true
+	}
+	return false
+}
@@ -29,3 +29,129 @@ func Lock(lock string) Option {
 		g.lock = lock
 	}
 }
+
+// Builder enables generating builder type to genarator.
+func Builder(builder bool) Option {
+	return func(g *generator) {
+		g.builder = builder
+	}
+}
+
+// Options enables generating functional options to genarator.
+func Options(options bool) Option {
+	return func(g *generator) {
+		g.options = options
+	}
+}
+
+// Constructor enables generating constructor to genarator.
+func Constructor(constructor bool) Option {
+	return func(g *generator) {
+		g.constructor = constructor
+	}
+}
+
+// ConstructorName sets constructor name to genarator.
+func ConstructorName(name string) Option {
+	return func(g *generator) {
+		g.constructorName = name
+	}
+}
+
+// Dirty sets field name recording dirty fields to genarator.
+func Dirty(dirty string) Option {
+	return func(g *generator) {
+		g.dirty = dirty
+	}
+}
+
+// Registry enables generating field registry to genarator.
+func Registry(registry bool) Option {
+	return func(g *generator) {
+		g.registry = registry
+	}
+}
+
+// JSON enables generating JSON marshaler and unmarshaler to genarator.
+func JSON(json bool) Option {
+	return func(g *generator) {
+		g.json = json
+	}
+}
+
+// LogValuer enables generating LogValue, String and GoString methods to genarator.
+func LogValuer(logValuer bool) Option {
+	return func(g *generator) {
+		g.logValuer = logValuer
+	}
+}
+
+// Interface enables generating interfaces of accessor methods to genarator.
+func Interface(iface bool) Option {
+	return func(g *generator) {
+		g.iface = iface
+	}
+}
+
+// ReaderName sets name of the interface of methods reading fields to genarator.
+func ReaderName(name string) Option {
+	return func(g *generator) {
+		g.readerName = name
+	}
+}
+
+// WriterName sets name of the interface of methods writing fields to genarator.
+func WriterName(name string) Option {
+	return func(g *generator) {
+		g.writerName = name
+	}
+}
+
+// Fake enables generating fakes of the interfaces of accessor methods to genarator.
+func Fake(fake bool) Option {
+	return func(g *generator) {
+		g.fake = fake
+	}
+}
+
+// View enables generating a read-only view of the struct to genarator.
+func View(view bool) Option {
+	return func(g *generator) {
+		g.view = view
+	}
+}
+
+// Clone enables generating a method returning a deep copy of the struct to genarator.
+func Clone(clone bool) Option {
+	return func(g *generator) {
+		g.clone = clone
+	}
+}
+
+// Equal enables generating a method comparing the tagged fields to genarator.
+func Equal(equal bool) Option {
+	return func(g *generator) {
+		g.equal = equal
+	}
+}
+
+// Patch enables generating a patch type and a method applying it to genarator.
+func Patch(patch bool) Option {
+	return func(g *generator) {
+		g.patch = patch
+	}
+}
+
+// SkipExisting enables skipping accessor methods already declared by hand to genarator.
+func SkipExisting(skip bool) Option {
+	return func(g *generator) {
+		g.skipExisting = skip
+	}
+}
+
+// Initialisms adds initialisms written in upper case in method names to genarator.
+func Initialisms(initialisms ...string) Option {
+	return func(g *generator) {
+		g.userInitialisms = append(g.userInitialisms, initialisms...)
+	}
+}
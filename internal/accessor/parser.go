@@ -1,7 +1,10 @@
 package accessor
 
 import (
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/token"
 	"go/types"
 	"path/filepath"
 	"reflect"
@@ -12,18 +15,44 @@ import (
 )
 
 const (
-	accessorTag     = "accessor"
-	ignoreTag       = "-"
-	tagKeyGetter    = "getter"
-	tagKeySetter    = "setter"
-	tagKeyNoDefault = "noDefault"
+	accessorTag       = "accessor"
+	ignoreTag         = "-"
+	tagKeyGetter      = "getter"
+	tagKeySetter      = "setter"
+	tagKeyWith        = "with"
+	tagKeyNoDefault   = "noDefault"
+	tagKeyRequired    = "required"
+	tagKeyOption      = "option"
+	tagKeyDefault     = "default"
+	tagKeyLazy        = "lazy"
+	tagKeyHas         = "has"
+	tagKeyClear       = "clear"
+	tagKeyOrZero      = "orZero"
+	tagKeyOr          = "or"
+	tagKeyUnwrap      = "unwrap"
+	tagKeySwap        = "swap"
+	tagKeyCAS         = "cas"
+	tagKeyOnChange    = "onChange"
+	tagKeyChangedOnly = "changedOnly"
+	tagKeyJSON        = "json"
+	tagKeyOmitEmpty   = "omitempty"
+	tagKeySecret      = "secret"
+	tagKeyNoEqual     = "noequal"
 )
 
+// directivePrefix is the prefix of the comment specifying tags for all fields of a struct.
+const directivePrefix = "//accessory:"
+
 const (
 	tagSep         = ","
 	tagKeyValueSep = ":"
 )
 
+const (
+	jsonTag          = "json"
+	jsonTagOmitEmpty = "omitempty"
+)
+
 func Parse(dir string) (*ParsedSource, error) {
 	const mode = packages.NeedName | packages.NeedFiles |
 		packages.NeedImports | packages.NeedTypes | packages.NeedSyntax
@@ -33,11 +62,13 @@ func Parse(dir string) (*ParsedSource, error) {
 		return nil, err
 	}
 
+	// Load the package in its directory, so that the module containing it is used.
 	cfg := &packages.Config{
 		Mode:  mode,
+		Dir:   dir,
 		Tests: false,
 	}
-	pkgs, err := packages.Load(cfg, dir)
+	pkgs, err := packages.Load(cfg, ".")
 	if err != nil {
 		return nil, err
 	}
@@ -45,11 +76,16 @@ func Parse(dir string) (*ParsedSource, error) {
 		return nil, fmt.Errorf("error: %d packages found", len(pkgs))
 	}
 
+	structs, err := parseStructs(pkgs[0])
+	if err != nil {
+		return nil, err
+	}
+
 	return &ParsedSource{
 		Package: pkgs[0],
 		Dir:     dir,
 		Imports: parseImports(pkgs[0]),
-		Structs: parseStructs(pkgs[0]),
+		Structs: structs,
 	}, nil
 }
 
@@ -91,23 +127,126 @@ func parseImports(pkg *packages.Package) []*Import {
 	return imports
 }
 
-func parseStructs(pkg *packages.Package) []*Struct {
+func parseStructs(pkg *packages.Package) ([]*Struct, error) {
+	directives, err := parseDirectives(pkg)
+	if err != nil {
+		return nil, err
+	}
+
 	scope := pkg.Types.Scope()
 	structs := make([]*Struct, 0, len(scope.Names()))
 	for _, name := range scope.Names() {
-		st, ok := scope.Lookup(name).Type().Underlying().(*types.Struct)
+		typ := scope.Lookup(name).Type()
+		st, ok := typ.Underlying().(*types.Struct)
 		if !ok {
 			continue
 		}
 
+		fields, err := parseFields(pkg.Fset, st, directives.structs[name], directives.fields)
+		if err != nil {
+			return nil, err
+		}
+
 		structs = append(structs, &Struct{
 			Name:     name,
-			Fields:   parseFields(st),
+			Type:     typ,
+			Fields:   fields,
 			LockType: detectLockType(st),
 		})
 	}
 
-	return structs
+	return structs, nil
+}
+
+// directives contains the directives in comments of a package, e.g. "getter,setter" for "//accessory:getter,setter".
+type directives struct {
+	structs map[string]string    // directives in doc comments of structs by struct names
+	fields  map[token.Pos]string // directives in comments of fields by positions of field names
+}
+
+// parseDirectives returns the directives in doc comments of struct declarations
+// and in comments on the line above a field or trailing it.
+func parseDirectives(pkg *packages.Package) (*directives, error) {
+	d := &directives{
+		structs: make(map[string]string),
+		fields:  make(map[token.Pos]string),
+	}
+
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				// The doc comment of a single type declaration is attached to the declaration.
+				doc := typeSpec.Doc
+				if doc == nil && len(genDecl.Specs) == 1 {
+					doc = genDecl.Doc
+				}
+				if directive, ok := findDirective(doc); ok {
+					d.structs[typeSpec.Name.Name] = directive
+				}
+
+				st, ok := typeSpec.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+				for _, field := range st.Fields.List {
+					above, hasAbove := findDirective(field.Doc)
+					trailing, hasTrailing := findDirective(field.Comment)
+					if hasAbove && hasTrailing {
+						return nil, fmt.Errorf("%s: accessory comments are specified both above and trailing the field",
+							pkg.Fset.Position(field.Pos()))
+					}
+					if !hasAbove && !hasTrailing {
+						continue
+					}
+
+					directive := above + trailing
+					for _, name := range field.Names {
+						d.fields[name.Pos()] = directive
+					}
+					if len(field.Names) == 0 {
+						// The position of an embedded field is the position of its type name.
+						d.fields[embeddedTypeName(field.Type).Pos()] = directive
+					}
+				}
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// findDirective returns the directive in the comment group, if any.
+func findDirective(cg *ast.CommentGroup) (string, bool) {
+	if cg == nil {
+		return "", false
+	}
+	for _, comment := range cg.List {
+		if directive, ok := strings.CutPrefix(comment.Text, directivePrefix); ok {
+			return strings.TrimSpace(directive), true
+		}
+	}
+	return "", false
+}
+
+// embeddedTypeName returns the identifier of the type name of an embedded field, e.g. T of *pkg.T.
+func embeddedTypeName(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return embeddedTypeName(e.X)
+	case *ast.SelectorExpr:
+		return e.Sel
+	case *ast.IndexExpr:
+		return embeddedTypeName(e.X)
+	case *ast.IndexListExpr:
+		return embeddedTypeName(e.X)
+	}
+	return expr
 }
 
 // detectLockType determines the type of lock used in a struct by examining its fields.
@@ -133,50 +272,310 @@ func detectLockType(st *types.Struct) LockType {
 	return LockTypeNone
 }
 
-func parseFields(st *types.Struct) []*Field {
+func parseFields(
+	fset *token.FileSet,
+	st *types.Struct,
+	directive string,
+	fieldDirectives map[token.Pos]string,
+) ([]*Field, error) {
 	fields := make([]*Field, st.NumFields())
 	for i := 0; i < st.NumFields(); i++ {
-		tag := parseTag(st.Tag(i))
 		field := st.Field(i)
+		tag := st.Tag(i)
+		_, hasTag := reflect.StructTag(tag).Lookup(accessorTag)
+		fromDirective := false
+
+		if fieldDirective, ok := fieldDirectives[field.Pos()]; ok {
+			if hasTag {
+				return nil, fmt.Errorf("%s: field %s has both accessor tag and accessory comment",
+					fset.Position(field.Pos()), field.Name())
+			}
+			// The comment of the field is handled as the accessor tag.
+			tag = fmt.Sprintf("%s:%q %s", accessorTag, fieldDirective, tag)
+		} else if !hasTag && directive != "" && !field.Embedded() && !field.Exported() &&
+			field.Name() != "_" && !isLock(field.Type()) {
+			// The directive of the struct applies to the unexported fields without accessor tag,
+			// except embedded fields, blank fields and locks.
+			tag = fmt.Sprintf("%s:%q %s", accessorTag, directive, tag)
+			fromDirective = true
+		}
+
+		parsedTag, err := parseTag(tag)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid accessor tag of field %s: %w",
+				fset.Position(field.Pos()), field.Name(), err)
+		}
 
 		fields[i] = &Field{
-			Name: field.Name(),
-			Type: field.Type(),
-			Tag:  tag,
+			Name:      field.Name(),
+			Type:      field.Type(),
+			Tag:       parsedTag,
+			Pos:       field.Pos(),
+			Directive: fromDirective,
 		}
 	}
 
-	return fields
+	return fields, nil
 }
 
-func parseTag(tag string) *Tag {
-	tagStr, ok := reflect.StructTag(strings.Trim(tag, "`")).Lookup(accessorTag)
+// isLock reports whether t is a lock of sync package.
+func isLock(t types.Type) bool {
+	named, ok := t.(*types.Named)
 	if !ok {
-		return nil
+		return false
+	}
+	pkg := named.Obj().Pkg()
+	return pkg != nil && pkg.Path() == "sync" &&
+		(named.Obj().Name() == "Mutex" || named.Obj().Name() == "RWMutex")
+}
+
+// containsLock reports whether a value of type t holds a lock which must not be copied,
+// such as sync.Mutex itself, or a struct or an array holding it, in the same way as copylocks of go vet.
+func containsLock(t types.Type) bool {
+	t = types.Unalias(t)
+	switch u := t.Underlying().(type) {
+	case *types.Pointer, *types.Interface:
+		return false
+	case *types.Array:
+		return containsLock(u.Elem())
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			if containsLock(u.Field(i).Type()) {
+				return true
+			}
+		}
 	}
 
-	var getter, setter *string
-	var noDefault bool
+	// Types whose pointers have Lock and Unlock methods are locks.
+	methods := types.NewMethodSet(types.NewPointer(t))
+	return methods.Lookup(nil, "Lock") != nil && methods.Lookup(nil, "Unlock") != nil
+}
+
+func parseTag(tag string) (*Tag, error) {
+	structTag := reflect.StructTag(strings.Trim(tag, "`"))
+	tagStr, ok := structTag.Lookup(accessorTag)
+	if !ok || tagStr == ignoreTag {
+		return nil, nil
+	}
 
-	tags := strings.Split(tagStr, tagSep)
+	var getter, setter, with, option, defaultValue *string
+	var has, clear, orZero, or *string
+	var swap, cas, onChange, jsonName *string
+	var noDefault, required, lazy, unwrap, changedOnly, omitEmpty, secret, noEqual bool
+
+	seen := make(map[string]struct{})
+	tags := splitTag(tagStr)
 	for _, tag := range tags {
-		keyValue := strings.Split(tag, tagKeyValueSep)
+		// Split only at the first separator, since default values may contain it.
+		keyValue := strings.SplitN(tag, tagKeyValueSep, 2)
+		key := strings.TrimSpace(keyValue[0])
 
 		var value string
 		if len(keyValue) == 2 {
-			if v := strings.TrimSpace(keyValue[1]); v != ignoreTag {
+			// "-" of json is kept to ignore the field in JSON.
+			if v := strings.TrimSpace(keyValue[1]); v != ignoreTag || key == tagKeyJSON {
 				value = v
 			}
 		}
-		switch strings.TrimSpace(keyValue[0]) {
+		if err := checkTagKey(key, value, len(keyValue) == 2, seen); err != nil {
+			return nil, err
+		}
+
+		switch key {
 		case tagKeyGetter:
 			getter = &value
 		case tagKeySetter:
 			setter = &value
+		case tagKeyWith:
+			with = &value
 		case tagKeyNoDefault:
 			noDefault = true
+		case tagKeyRequired:
+			required = true
+		case tagKeyOption:
+			option = &value
+		case tagKeyDefault:
+			defaultValue = &value
+		case tagKeyLazy:
+			lazy = true
+		case tagKeyHas:
+			has = &value
+		case tagKeyClear:
+			clear = &value
+		case tagKeyOrZero:
+			orZero = &value
+		case tagKeyOr:
+			or = &value
+		case tagKeyUnwrap:
+			unwrap = true
+		case tagKeySwap:
+			swap = &value
+		case tagKeyCAS:
+			cas = &value
+		case tagKeyOnChange:
+			onChange = &value
+		case tagKeyChangedOnly:
+			changedOnly = true
+		case tagKeyJSON:
+			jsonName = &value
+		case tagKeyOmitEmpty:
+			omitEmpty = true
+		case tagKeySecret:
+			secret = true
+		case tagKeyNoEqual:
+			noEqual = true
+		}
+	}
+
+	// If the JSON name isn't specified in the accessor tag, use the json tag of the field.
+	if jsonStr, ok := structTag.Lookup(jsonTag); ok && jsonName == nil {
+		name, opts, _ := strings.Cut(jsonStr, tagSep)
+		jsonName = &name
+		omitEmpty = omitEmpty || slices.Contains(strings.Split(opts, tagSep), jsonTagOmitEmpty)
+	}
+
+	return &Tag{
+		Setter:      setter,
+		Getter:      getter,
+		With:        with,
+		NoDefault:   noDefault,
+		Required:    required,
+		Option:      option,
+		Default:     defaultValue,
+		Lazy:        lazy,
+		Has:         has,
+		Clear:       clear,
+		OrZero:      orZero,
+		Or:          or,
+		Unwrap:      unwrap,
+		Swap:        swap,
+		CAS:         cas,
+		OnChange:    onChange,
+		ChangedOnly: changedOnly,
+		JSON:        jsonName,
+		OmitEmpty:   omitEmpty,
+		Secret:      secret,
+		NoEqual:     noEqual,
+	}, nil
+}
+
+// splitTag splits the value of accessor tag at the separators,
+// except the ones in brackets and literals of default values, e.g. []string{"a", "b"}.
+func splitTag(tag string) []string {
+	var tags []string
+	var quote rune
+	depth, start, escaped := 0, 0, false
+
+	for i, r := range tag {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if r == '\\' && quote != '`' {
+				escaped = true
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'' || r == '`':
+			quote = r
+		case r == '(' || r == '[' || r == '{':
+			depth++
+		case r == ')' || r == ']' || r == '}':
+			depth--
+		case string(r) == tagSep && depth == 0:
+			tags = append(tags, tag[start:i])
+			start = i + len(tagSep)
+		}
+	}
+
+	return append(tags, tag[start:])
+}
+
+// tagKeysWithoutValue are the keys of accessor tag which take no value.
+var tagKeysWithoutValue = []string{
+	tagKeyNoDefault, tagKeyRequired, tagKeyLazy, tagKeyUnwrap,
+	tagKeyChangedOnly, tagKeyOmitEmpty, tagKeySecret, tagKeyNoEqual,
+}
+
+// tagKeysWithName are the keys of accessor tag which take a method or function name as the value.
+var tagKeysWithName = []string{
+	tagKeyGetter, tagKeySetter, tagKeyWith, tagKeyOption, tagKeyHas, tagKeyClear,
+	tagKeyOrZero, tagKeyOr, tagKeySwap, tagKeyCAS, tagKeyOnChange,
+}
+
+// tagKeysRequiringValue are the keys of accessor tag which can't be used without a value.
+var tagKeysRequiringValue = []string{tagKeyDefault, tagKeyOnChange}
+
+// tagKeys are all keys of accessor tag.
+var tagKeys = slices.Concat(tagKeysWithoutValue, tagKeysWithName, []string{tagKeyDefault, tagKeyJSON})
+
+// checkTagKey checks that the key of accessor tag is known and not duplicated,
+// and that the value is valid for the key.
+func checkTagKey(key, value string, hasValue bool, seen map[string]struct{}) error {
+	if key == "" {
+		return errors.New("empty key")
+	}
+	if !slices.Contains(tagKeys, key) {
+		if suggestion := closestTagKey(key); suggestion != "" {
+			return fmt.Errorf("unknown key %q; did you mean %q?", key, suggestion)
+		}
+		return fmt.Errorf("unknown key %q", key)
+	}
+	if _, ok := seen[key]; ok {
+		return fmt.Errorf("duplicate key %q", key)
+	}
+	seen[key] = struct{}{}
+
+	if hasValue && slices.Contains(tagKeysWithoutValue, key) {
+		return fmt.Errorf("key %q takes no value", key)
+	}
+	if value == "" && slices.Contains(tagKeysRequiringValue, key) {
+		return fmt.Errorf("key %q requires a value", key)
+	}
+	if value != "" && slices.Contains(tagKeysWithName, key) && !token.IsIdentifier(value) {
+		return fmt.Errorf("name %q for key %q is not a valid identifier", value, key)
+	}
+
+	return nil
+}
+
+// closestTagKey returns the known key closest to the unknown key,
+// or an empty string if no key is close enough.
+func closestTagKey(key string) string {
+	const maxDistance = 2
+
+	closest, minDistance := "", maxDistance+1
+	for _, known := range tagKeys {
+		if strings.EqualFold(key, known) {
+			return known
+		}
+		if d := editDistance(strings.ToLower(key), strings.ToLower(known)); d < minDistance {
+			closest, minDistance = known, d
+		}
+	}
+
+	return closest
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
 		}
+		prev, curr = curr, prev
 	}
 
-	return &Tag{Setter: setter, Getter: getter, NoDefault: noDefault}
+	return prev[len(b)]
 }
@@ -0,0 +1,202 @@
+package accessor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// writeSource writes the source as tester.go of a package in a temporary module,
+// and returns the directory of the package and the path of the file.
+func writeSource(t *testing.T, src string) (string, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module test\n\ngo 1.25\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "tester.go")
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir, path
+}
+
+func TestParseTagErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		tag  string
+		want string
+	}{
+		"UnknownKeyWithSuggestion": {
+			tag:  "geter",
+			want: `unknown key "geter"; did you mean "getter"?`,
+		},
+		"UnknownKeyInWrongCase": {
+			tag:  "nodefault",
+			want: `unknown key "nodefault"; did you mean "noDefault"?`,
+		},
+		"UnknownKeyWithoutSuggestion": {
+			tag:  "getter,xyzzy",
+			want: `unknown key "xyzzy"`,
+		},
+		"DuplicateKey": {
+			tag:  "getter,setter,getter",
+			want: `duplicate key "getter"`,
+		},
+		"InvalidIdentifier": {
+			tag:  "getter:1Field",
+			want: `name "1Field" for key "getter" is not a valid identifier`,
+		},
+		"TakesNoValue": {
+			tag:  "getter,lazy:true",
+			want: `key "lazy" takes no value`,
+		},
+		"MissingValue": {
+			tag:  "getter,default",
+			want: `key "default" requires a value`,
+		},
+		"EmptyKey": {
+			tag:  "getter,",
+			want: `empty key`,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir, path := writeSource(t, fmt.Sprintf("package test\n\ntype Tester struct {\n\tfield1 []string `accessor:%q`\n}\n", tt.tag))
+
+			_, err := Parse(dir)
+			want := fmt.Sprintf("%s:4:2: invalid accessor tag of field field1: %s", path, tt.want)
+			if err == nil || err.Error() != want {
+				t.Errorf("Parse() error = %v, want %s", err, want)
+			}
+		})
+	}
+}
+
+func TestParseFieldCommentErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		src  string
+		want string
+	}{
+		"TagAndComment": {
+			src:  "package test\n\ntype Tester struct {\n\tfield1 string `accessor:\"getter\"` //accessory:setter\n}\n",
+			want: "%[1]s:4:2: field field1 has both accessor tag and accessory comment",
+		},
+		"AboveAndTrailing": {
+			src:  "package test\n\ntype Tester struct {\n\t//accessory:getter\n\tfield1 string //accessory:setter\n}\n",
+			want: "%[1]s:5:2: accessory comments are specified both above and trailing the field",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir, path := writeSource(t, tt.src)
+
+			_, err := Parse(dir)
+			want := fmt.Sprintf(tt.want, path)
+			if err == nil || err.Error() != want {
+				t.Errorf("Parse() error = %v, want %s", err, want)
+			}
+		})
+	}
+}
+
+func TestParseDirectiveFields(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := writeSource(t, `package test
+
+import "sync"
+
+type Embedded struct{}
+
+//accessory:getter,setter
+type Tester struct {
+	Embedded
+	lock   sync.Mutex
+	Name   string
+	_      int
+	field1 string
+	field2 int `+"`accessor:\"getter\"`"+`
+}
+`)
+
+	src, err := Parse(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]bool{
+		"Embedded": false,
+		"lock":     false,
+		"Name":     false,
+		"_":        false,
+		"field1":   true,
+		"field2":   true,
+	}
+	for _, st := range src.Structs {
+		if st.Name != "Tester" {
+			continue
+		}
+		for _, field := range st.Fields {
+			if got := field.Tag != nil; got != want[field.Name] {
+				t.Errorf("field %s has tag = %t, want %t", field.Name, got, want[field.Name])
+			}
+		}
+	}
+}
+
+func TestSplitTag(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		tag  string
+		want []string
+	}{
+		"Keys": {
+			tag:  "getter,setter:SetName",
+			want: []string{"getter", "setter:SetName"},
+		},
+		"CompositeLiteral": {
+			tag:  `getter,default:[]string{"a", "b"}`,
+			want: []string{"getter", `default:[]string{"a", "b"}`},
+		},
+		"FunctionCall": {
+			tag:  "default:time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),getter",
+			want: []string{"default:time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)", "getter"},
+		},
+		"StringLiteral": {
+			tag:  `default:"a,\"b,c\"",getter`,
+			want: []string{`default:"a,\"b,c\""`, "getter"},
+		},
+		"RuneLiteral": {
+			tag:  "default:',',getter",
+			want: []string{"default:','", "getter"},
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := splitTag(tt.tag); !slices.Equal(got, tt.want) {
+				t.Errorf("splitTag(%q) = %q, want %q", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package accessor
 
 import (
+	"go/token"
 	"go/types"
 
 	"golang.org/x/tools/go/packages"
@@ -24,22 +25,48 @@ type Import struct {
 // Struct contains the information of a struct.
 type Struct struct {
 	Name     string
+	Type     types.Type
 	Fields   []*Field
 	LockType LockType
 }
 
 // Field contains the information of a field in a struct.
 type Field struct {
-	Name string
-	Type types.Type
-	Tag  *Tag
+	Name      string
+	Type      types.Type
+	Tag       *Tag
+	Pos       token.Pos
+	Directive bool // whether the tag is given by the directive of the struct
 }
 
 // Tag contains the information of a struct field's tag.
 type Tag struct {
-	Getter    *string
-	Setter    *string
-	NoDefault bool
+	Getter      *string
+	Setter      *string
+	With        *string
+	NoDefault   bool
+	Required    bool
+	Option      *string
+	Default     *string // Go expression used as the default value of the field
+	Lazy        bool
+	Has         *string
+	Clear       *string
+	OrZero      *string
+	Or          *string
+	Unwrap      bool
+	Swap        *string
+	CAS         *string
+	OnChange    *string // method called after the field is changed by the setter
+	ChangedOnly bool    // call OnChange only when the value is changed
+	JSON        *string // name of the field in JSON, "-" means the field is ignored
+	OmitEmpty   bool
+	Secret      bool // redact the field in logs and strings
+	NoEqual     bool // exclude the field from Equal
+}
+
+// hasOptionalMethods reports whether any of methods for optional values is specified.
+func (t *Tag) hasOptionalMethods() bool {
+	return t.Has != nil || t.Clear != nil || t.OrZero != nil || t.Or != nil
 }
 
 // LockType represents the type of lock used in a struct.